@@ -0,0 +1,228 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-user-api/internal/alarm"
+	"github.com/ozoncp/ocp-user-api/internal/config"
+	"github.com/ozoncp/ocp-user-api/internal/flusher"
+	"github.com/ozoncp/ocp-user-api/internal/importer"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/repo"
+	"github.com/ozoncp/ocp-user-api/internal/saver"
+)
+
+const importUsage = "usage: ocp-user-api [-config path] import [-format csv|ndjson] [-map field=column,...] [-rejects path] file"
+
+// Подкоманда import: загрузка пользователей из файла CSV или NDJSON.
+// Строки, не прошедшие разбор и проверку, а также несохраненные пользователи записываются в файл отклоненных строк
+// с номером строки и причиной. Пользователи сохраняются через буфер saver.Saver с настройками из раздела saver:
+// буфер сбрасывается после каждых saver.capacity строк и по таймеру. Причиной отклонения несохраненного пользователя
+// служит ошибка его сохранения: при нарушении уникальности или ограничения отклоняется только нарушающая строка,
+// остальные ошибки относятся ко всей пачке. Сохранитель закрывается по окончании файла, поэтому каждая строка
+// либо сохраняется, либо попадает в отклоненные.
+func runImport(ctx context.Context, cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	format := flags.String("format", "", "input format: csv or ndjson, detected by file extension if empty")
+	mappingSpec := flags.String("map", "", "column mapping field=column[,field=column...], empty column skips the field")
+	rejectsPath := flags.String("rejects", "", "reject file path, <file>.rejects.csv if empty")
+
+	if err := flags.Parse(args); err != nil {
+		return errors.New(importUsage)
+	}
+
+	if flags.NArg() != 1 {
+		return errors.New(importUsage)
+	}
+
+	path := flags.Arg(0)
+
+	if *format == "" {
+		*format = formatByExtension(path)
+	}
+
+	mapping, err := importer.ParseMapping(*mappingSpec)
+	if err != nil {
+		return err
+	}
+
+	input, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	var reader importer.Reader
+
+	switch *format {
+	case "csv":
+		reader = importer.NewCSVReader(input, mapping)
+	case "ndjson":
+		reader = importer.NewNDJSONReader(input, mapping)
+	default:
+		return fmt.Errorf("unknown format %q, expected csv or ndjson", *format)
+	}
+
+	if *rejectsPath == "" {
+		*rejectsPath = path + ".rejects.csv"
+	}
+
+	db, err := sqlx.ConnectContext(ctx, cfg.Database.Driver, cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	rejectsFile, err := os.Create(*rejectsPath)
+	if err != nil {
+		return err
+	}
+
+	defer rejectsFile.Close()
+
+	rejects := csv.NewWriter(rejectsFile)
+	if err := rejects.Write([]string{"line", "reason"}); err != nil {
+		return err
+	}
+
+	var (
+		total, created, rejected int
+		// Результаты сохранения приходят из горутины сохранителя, поэтому счетчики
+		// и файл отклоненных строк защищены мьютексом.
+		mutex    sync.Mutex
+		writeErr error
+	)
+
+	reject := func(line int, reason string) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		rejected++
+
+		if err := rejects.Write([]string{strconv.Itoa(line), reason}); err != nil && writeErr == nil {
+			writeErr = err
+		}
+	}
+
+	saverCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	saverAlarm := alarm.NewAlarm(saverCtx, cfg.Saver.FlushInterval)
+	saverAlarm.Init()
+
+	userSaver := saver.NewSaver(
+		cfg.Saver.Capacity,
+		saverAlarm,
+		flusher.NewFlusher(cfg.Saver.ChunkSize, cfg.Saver.Parallelism, repo.NewRepo(db)),
+	)
+	userSaver.Init(saverCtx)
+
+	buffer := userSaver.Open(ctx)
+
+	// Закрытие сохранителя сбрасывает оставшихся в буфере пользователей и дожидается результатов их сохранения.
+	closeSaver := func() {
+		if userSaver == nil {
+			return
+		}
+
+		userSaver.Close()
+		userSaver = nil
+
+		cancel()
+		saverAlarm.Close()
+	}
+
+	defer closeSaver()
+
+	unflushed := 0
+
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		total++
+
+		if row.Err == nil {
+			row.Err = mapping.Validate(row.User)
+		}
+
+		if row.Err != nil {
+			reject(row.Line, row.Err.Error())
+			continue
+		}
+
+		line := row.Line
+
+		buffer.Save(models.User{
+			CalendarId: row.User.CalendarId,
+			ResumeId:   row.User.ResumeId,
+			Name:       row.User.Profile.GetName(),
+			Surname:    row.User.Profile.GetSurname(),
+			Patronymic: row.User.Profile.GetPatronymic(),
+			Email:      row.User.Profile.GetEmail(),
+		}, func(err error) {
+			if err != nil {
+				reject(line, err.Error())
+				return
+			}
+
+			mutex.Lock()
+			created++
+			mutex.Unlock()
+		})
+
+		unflushed++
+		if unflushed == cfg.Saver.Capacity {
+			buffer.Flush()
+			unflushed = 0
+		}
+	}
+
+	closeSaver()
+
+	if writeErr != nil {
+		return writeErr
+	}
+
+	rejects.Flush()
+	if err := rejects.Error(); err != nil {
+		return err
+	}
+
+	fmt.Printf("read %d rows: %d users created, %d rows rejected\n", total, created, rejected)
+
+	if rejected > 0 {
+		return fmt.Errorf("%d rows rejected, see %s", rejected, *rejectsPath)
+	}
+
+	return nil
+}
+
+func formatByExtension(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	default:
+		return "csv"
+	}
+}
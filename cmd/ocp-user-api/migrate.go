@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-user-api/internal/config"
+	"github.com/ozoncp/ocp-user-api/internal/migrations"
+)
+
+const migrateUsage = "usage: ocp-user-api [-config path] migrate up|down [steps]|status"
+
+// Подкоманда migrate: применение, откат и просмотр состояния миграций схемы БД.
+func runMigrate(ctx context.Context, cfg *config.Config, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	db, err := sqlx.ConnectContext(ctx, cfg.Database.Driver, cfg.Database.DSN)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close()
+
+	migrator, err := migrations.NewMigrator(db)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			fmt.Printf("applied %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+
+		return err
+
+	case "down":
+		steps := 1
+
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("steps must be a positive integer, got %s", args[1])
+			}
+		}
+
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			fmt.Printf("reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+
+		if err == nil && len(reverted) == 0 {
+			fmt.Println("no applied migrations")
+		}
+
+		return err
+
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, status := range statuses {
+			appliedAt := "pending"
+			if status.AppliedAt != nil {
+				appliedAt = "applied at " + status.AppliedAt.Format("2006-01-02 15:04:05 MST")
+			}
+
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, appliedAt)
+		}
+
+		return nil
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
@@ -46,7 +46,13 @@ func (a *alarm) Init() {
 
 			select {
 			case <-timer:
-				a.alarms <- struct{}{}
+				select {
+				case a.alarms <- struct{}{}:
+				case <-a.ctx.Done():
+					close(a.alarms)
+					a.done <- struct{}{}
+					return
+				}
 				timer = time.After(a.timeout)
 			case <-a.ctx.Done():
 				close(a.alarms)
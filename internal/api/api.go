@@ -2,52 +2,94 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
 	spanlog "github.com/opentracing/opentracing-go/log"
 	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/ozoncp/ocp-user-api/internal/extractor"
+	"github.com/ozoncp/ocp-user-api/internal/idempotency"
+	"github.com/ozoncp/ocp-user-api/internal/importer"
 	"github.com/ozoncp/ocp-user-api/internal/models"
-	"github.com/ozoncp/ocp-user-api/internal/producer"
+	"github.com/ozoncp/ocp-user-api/internal/pagetoken"
 	"github.com/ozoncp/ocp-user-api/internal/repo"
+	"github.com/ozoncp/ocp-user-api/internal/saver"
 	"github.com/ozoncp/ocp-user-api/internal/utils"
+	"github.com/ozoncp/ocp-user-api/internal/workerpool"
 	desc "github.com/ozoncp/ocp-user-api/pkg/ocp-user-api"
 )
 
-const (
-	chunkSize = 10
-)
+var protoSortFields = map[desc.UserSort_Field]models.UserSortField{
+	desc.UserSort_ID:          models.SortById,
+	desc.UserSort_NAME:        models.SortByName,
+	desc.UserSort_SURNAME:     models.SortBySurname,
+	desc.UserSort_PATRONYMIC:  models.SortByPatronymic,
+	desc.UserSort_EMAIL:       models.SortByEmail,
+	desc.UserSort_CALENDAR_ID: models.SortByCalendarId,
+	desc.UserSort_RESUME_ID:   models.SortByResumeId,
+}
 
 type api struct {
 	desc.UnimplementedOcpUserApiServer
-	userRepo      repo.Repo
-	eventProducer producer.Producer
+	userRepo        repo.Repo
+	userExtractor   extractor.Extractor
+	userSaver       saver.Saver
+	pageTokens      pagetoken.Codec
+	idempotencyKeys idempotency.Store
+	chunkSize       int
+	parallelism     int
 }
 
 func (a *api) ListUsersV1(
 	ctx context.Context,
 	req *desc.ListUsersV1Request,
 ) (*desc.ListUsersV1Response, error) {
-	if err := req.Validate(); err != nil {
+	if err := req.ValidateAll(); err != nil {
 		log.Error().Err(err).Msg("invalid argument")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidArgument(err)
 	}
 
-	log.Info().Msgf("search users: Limit: %d, Offset: %d", req.Limit, req.Offset)
+	log.Info().Msgf("search users: Limit: %d, Filter: %v, Sort: %v", req.Limit, req.Filter, req.Sort)
+
+	sortField, exists := protoSortFields[req.Sort.GetField()]
+	if !exists {
+		log.Error().Msgf("unknown sort field %v", req.Sort.GetField())
+		return nil, invalidField("sort.field", "unknown sort field")
+	}
 
 	searchParams := models.UserSearchParams{
-		Count:  req.Limit,
-		Offset: req.Offset,
+		Count:     req.Limit,
+		Filter:    protoFilterToRepoFilter(req.Filter),
+		SortField: sortField,
+		SortDesc:  req.Sort.GetDirection() == desc.UserSort_DESC,
 	}
+
+	if req.PageToken != "" {
+		after, err := a.pageTokens.Decode(req.PageToken, searchParams)
+		if err != nil {
+			log.Error().Err(err).Msg("invalid page token")
+			return nil, invalidField("pageToken", err.Error())
+		}
+
+		searchParams.After = after
+	}
+
 	searchResult, err := a.userRepo.SearchUsers(ctx, searchParams)
 
 	if err != nil {
-		log.Error().Err(err).Msg("internal error")
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Error().Err(err).Msg("failed to search users")
+		return nil, repoError(err, 0)
 	}
 
 	users := make([]*desc.User, 0, len(searchResult.Items))
@@ -55,35 +97,115 @@ func (a *api) ListUsersV1(
 		users = append(users, repoUserToProtoUser(&user))
 	}
 
-	log.Info().Msgf("found %d users, NextOffset: %d", len(users), searchResult.NextOffset)
+	var nextPageToken string
+
+	if searchResult.Next != nil {
+		nextPageToken, err = a.pageTokens.Encode(searchParams, *searchResult.Next)
+		if err != nil {
+			log.Error().Err(err).Msg("internal error")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	log.Info().Msgf("found %d users, has next page: %t", len(users), nextPageToken != "")
 
 	return &desc.ListUsersV1Response{
-		Users:      users,
-		NextOffset: searchResult.NextOffset,
+		Users:         users,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+func (a *api) ExportUsersV1(
+	req *desc.ExportUsersV1Request,
+	stream desc.OcpUserApi_ExportUsersV1Server,
+) error {
+	span, ctx := opentracing.StartSpanFromContext(stream.Context(), "ExportUsersV1")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return invalidArgument(err)
+	}
+
+	log.Info().Msgf("export users: Filter: %v, Sort: %v", req.Filter, req.Sort)
+
+	sortField, exists := protoSortFields[req.Sort.GetField()]
+	if !exists {
+		log.Error().Msgf("unknown sort field %v", req.Sort.GetField())
+		return invalidField("sort.field", "unknown sort field")
+	}
+
+	searchParams := models.UserSearchParams{
+		Filter:    protoFilterToRepoFilter(req.Filter),
+		SortField: sortField,
+		SortDesc:  req.Sort.GetDirection() == desc.UserSort_DESC,
+	}
+
+	var count int
+
+	// Send блокируется, пока клиент не примет данные, поэтому курсор читается не быстрее клиента.
+	err := a.userRepo.ExportUsers(ctx, searchParams, a.chunkSize, func(users []models.User) error {
+		response := &desc.ExportUsersV1Response{
+			Users: make([]*desc.User, 0, len(users)),
+		}
+
+		for i := range users {
+			response.Users = append(response.Users, repoUserToProtoUser(&users[i]))
+		}
+
+		count += len(users)
+
+		return stream.Send(response)
+	})
+
+	if err != nil {
+		log.Error().Err(err).Int("count", count).Msg("failed to export users")
+
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+
+		return repoError(err, 0)
+	}
+
+	log.Info().Int("count", count).Msg("users were exported")
+
+	return nil
+}
+
 func (a *api) DescribeUserV1(
 	ctx context.Context,
 	req *desc.DescribeUserV1Request,
 ) (*desc.DescribeUserV1Response, error) {
-	if err := req.Validate(); err != nil {
+	if err := req.ValidateAll(); err != nil {
 		log.Error().Err(err).Msg("invalid argument")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidArgument(err)
 	}
 
-	log.Info().Uint64("userId", req.UserId).Msg("get user")
+	var (
+		user *models.User
+		err  error
+	)
+
+	if req.AsOf != nil {
+		if err := req.AsOf.CheckValid(); err != nil {
+			log.Error().Err(err).Msg("invalid argument")
+			return nil, invalidField("asOf", err.Error())
+		}
 
-	user, err := a.userRepo.GetUser(ctx, req.UserId)
+		asOf := req.AsOf.AsTime()
+		log.Info().Uint64("userId", req.UserId).Time("asOf", asOf).Msg("get user as of")
 
-	if err != nil {
-		log.Error().Err(err).Msg("internal error")
-		return nil, status.Error(codes.Internal, err.Error())
+		user, err = a.userRepo.GetUserAsOf(ctx, req.UserId, asOf)
+	} else {
+		log.Info().Uint64("userId", req.UserId).Msg("get user")
+
+		user, err = a.userRepo.GetUser(ctx, req.UserId)
 	}
 
-	if user == nil {
-		log.Info().Uint64("userId", req.UserId).Msg("user not found")
-		return nil, status.Error(codes.NotFound, "user was not found")
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("failed to get user")
+		return nil, repoError(err, req.UserId)
 	}
 
 	log.Debug().Msgf(" found user %v", user)
@@ -93,79 +215,127 @@ func (a *api) DescribeUserV1(
 	}, nil
 }
 
+func (a *api) BatchDescribeUsersV1(
+	ctx context.Context,
+	req *desc.BatchDescribeUsersV1Request,
+) (*desc.BatchDescribeUsersV1Response, error) {
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
+	}
+
+	log.Info().Msgf("get %d users", len(req.UserIds))
+
+	extractResult := a.userExtractor.Extract(ctx, req.UserIds)
+
+	users := make([]*desc.User, 0, len(extractResult.Users))
+	for _, user := range extractResult.Users {
+		users = append(users, repoUserToProtoUser(&user))
+	}
+
+	userErrors := make([]*desc.UserError, 0, len(extractResult.Errors))
+	for _, userId := range req.UserIds {
+		err, exists := extractResult.Errors[userId]
+		if !exists {
+			continue
+		}
+
+		userError := &desc.UserError{
+			UserId:  userId,
+			Reason:  desc.UserError_INTERNAL,
+			Message: "internal error",
+		}
+
+		if err == extractor.UserNotFoundError {
+			userError.Reason = desc.UserError_NOT_FOUND
+			userError.Message = "user was not found"
+		} else {
+			log.Error().Err(err).Uint64("userId", userId).Msg("internal error")
+		}
+
+		userErrors = append(userErrors, userError)
+	}
+
+	log.Info().Msgf("found %d users, failed %d users", len(users), len(userErrors))
+
+	return &desc.BatchDescribeUsersV1Response{
+		Users:  users,
+		Errors: userErrors,
+	}, nil
+}
+
 func (a *api) CreateUserV1(
 	ctx context.Context,
 	req *desc.CreateUserV1Request,
 ) (*desc.CreateUserV1Response, error) {
-	if err := req.Validate(); err != nil {
-		log.Error().Err(err).Msg("invalid argument")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
+	span, ctx := opentracing.StartSpanFromContext(ctx, "CreateUserV1")
+	defer span.Finish()
 
-	user := &models.User{
-		CalendarId: req.CalendarId,
-		ResumeId:   req.ResumeId,
-		Name:       req.Profile.GetName(),
-		Surname:    req.Profile.GetSurname(),
-		Patronymic: req.Profile.GetPatronymic(),
-		Email:      req.Profile.GetEmail(),
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
 	}
-	userId, err := a.userRepo.CreateUser(ctx, user)
 
+	key, err := idempotencyKey(ctx, req.IdempotencyKey)
 	if err != nil {
-		log.Error().Err(err).Msg("internal error")
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, err
 	}
 
-	err = a.eventProducer.SendEvent(producer.Event{
-		Type: producer.Created,
-		Payload: map[string]interface{}{
-			"Id": userId,
-		},
+	response := &desc.CreateUserV1Response{}
+
+	err = a.idempotent(ctx, "CreateUserV1", key, req, response, func() ([]uint64, error) {
+		user := &models.User{
+			CalendarId: req.CalendarId,
+			ResumeId:   req.ResumeId,
+			Name:       req.Profile.GetName(),
+			Surname:    req.Profile.GetSurname(),
+			Patronymic: req.Profile.GetPatronymic(),
+			Email:      req.Profile.GetEmail(),
+		}
+		userId, err := a.userRepo.CreateUser(ctx, user)
+
+		if err != nil {
+			log.Error().Err(err).Msg("failed to create user")
+			return nil, repoError(err, 0)
+		}
+
+		log.Info().Uint64("userId", userId).Msg("create new user")
+
+		response.UserId = userId
+		return []uint64{userId}, nil
 	})
 
 	if err != nil {
-		log.Error().Err(err).Msg("publish telemetry event")
+		return nil, err
 	}
 
-	log.Info().Uint64("userId", userId).Msg("create new user")
-
-	return &desc.CreateUserV1Response{
-		UserId: userId,
-	}, nil
+	return response, nil
 }
 
 func (a *api) RemoveUserV1(
 	ctx context.Context,
 	req *desc.RemoveUserV1Request,
 ) (*desc.RemoveUserV1Response, error) {
-	if err := req.Validate(); err != nil {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RemoveUserV1")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
 		log.Error().Err(err).Msg("invalid argument")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidArgument(err)
 	}
 
 	log.Info().Uint64("userId", req.UserId).Msg("remove user")
 
-	isDeleted, err := a.userRepo.RemoveUser(ctx, req.UserId)
+	isDeleted, err := a.userRepo.RemoveUser(ctx, req.UserId, req.ExpectedVersion)
 
 	if err != nil {
-		log.Error().Err(err).Msg("internal error")
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("failed to remove user")
+		return nil, repoError(err, req.UserId)
 	}
 
 	if isDeleted {
 		log.Info().Uint64("userId", req.UserId).Msg("user was deleted")
-
-		err := a.eventProducer.SendEvent(producer.Event{
-			Type: producer.Removed,
-			Payload: map[string]interface{}{
-				"Id": req.UserId,
-			},
-		})
-
-		if err != nil {
-			log.Error().Err(err).Msg("publish telemetry event")
-		}
 	}
 
 	return &desc.RemoveUserV1Response{
@@ -173,49 +343,330 @@ func (a *api) RemoveUserV1(
 	}, nil
 }
 
+func (a *api) RestoreUserV1(
+	ctx context.Context,
+	req *desc.RestoreUserV1Request,
+) (*desc.RestoreUserV1Response, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "RestoreUserV1")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
+	}
+
+	log.Info().Uint64("userId", req.UserId).Msg("restore user")
+
+	isRestored, err := a.userRepo.RestoreUser(ctx, req.UserId)
+
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("failed to restore user")
+		return nil, repoError(err, req.UserId)
+	}
+
+	if isRestored {
+		log.Info().Uint64("userId", req.UserId).Msg("user was restored")
+	}
+
+	return &desc.RestoreUserV1Response{
+		Restored: isRestored,
+	}, nil
+}
+
+func (a *api) PurgeDeletedUsersV1(
+	ctx context.Context,
+	req *desc.PurgeDeletedUsersV1Request,
+) (*desc.PurgeDeletedUsersV1Response, error) {
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
+	}
+
+	if err := req.DeletedBefore.CheckValid(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidField("deletedBefore", err.Error())
+	}
+
+	deletedBefore := req.DeletedBefore.AsTime()
+
+	log.Info().Time("deletedBefore", deletedBefore).Msg("purge deleted users")
+
+	purged, err := a.userRepo.PurgeDeletedUsers(ctx, deletedBefore)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to purge deleted users")
+		return nil, repoError(err, 0)
+	}
+
+	log.Info().Uint64("count", purged).Msg("deleted users were purged")
+
+	return &desc.PurgeDeletedUsersV1Response{
+		Purged: purged,
+	}, nil
+}
+
 func (a *api) UpdateUserV1(
 	ctx context.Context,
 	req *desc.UpdateUserV1Request,
 ) (*desc.UpdateUserV1Response, error) {
-	if err := req.Validate(); err != nil {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "UpdateUserV1")
+	defer span.Finish()
+
+	fields, err := updateMaskToUserFields(req.UpdateMask.GetPaths())
+	if err != nil {
 		log.Error().Err(err).Msg("invalid argument")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, invalidField("updateMask", err.Error())
 	}
 
-	log.Info().Uint64("userId", req.UserId).Msg("update user")
+	violations := validationViolations("", req.ValidateAll())
+	if violations = maskedViolations(violations, "userParams.", fields); len(violations) > 0 {
+		log.Error().Int("violations", len(violations)).Msg("invalid argument")
+		return nil, invalidViolations(violations)
+	}
 
-	updated, err := a.userRepo.UpdateUser(ctx, &models.User{
-		CalendarId: req.UserParams.CalendarId,
-		ResumeId:   req.UserParams.ResumeId,
-		Name:       req.UserParams.Profile.GetName(),
-		Surname:    req.UserParams.Profile.GetSurname(),
-		Patronymic: req.UserParams.Profile.GetPatronymic(),
-		Email:      req.UserParams.Profile.GetEmail(),
-	})
+	log.Info().Uint64("userId", req.UserId).Strs("updateMask", req.UpdateMask.GetPaths()).Msg("update user")
+
+	user := &models.User{
+		Id:         req.UserId,
+		CalendarId: req.UserParams.GetCalendarId(),
+		ResumeId:   req.UserParams.GetResumeId(),
+		Name:       req.UserParams.GetProfile().GetName(),
+		Surname:    req.UserParams.GetProfile().GetSurname(),
+		Patronymic: req.UserParams.GetProfile().GetPatronymic(),
+		Email:      req.UserParams.GetProfile().GetEmail(),
+		Version:    req.ExpectedVersion,
+	}
+
+	updated, err := a.userRepo.UpdateUser(ctx, user, fields)
 
 	if err != nil {
-		log.Error().Err(err).Msg("internal error")
-		return nil, status.Error(codes.Internal, err.Error())
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("failed to update user")
+		return nil, repoError(err, req.UserId)
 	}
 
 	if updated {
 		log.Info().Uint64("userId", req.UserId).Msg("user was updated")
+	}
+
+	response := &desc.UpdateUserV1Response{
+		Updated: updated,
+	}
+
+	if updated {
+		response.Version = user.Version
+	}
+
+	return response, nil
+}
+
+func (a *api) ImportUsersV1(stream desc.OcpUserApi_ImportUsersV1Server) error {
+	progress, err := a.importUsers(stream.Context(), stream.Recv, nil)
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(progress)
+}
+
+func (a *api) ImportUsersWithProgressV1(stream desc.OcpUserApi_ImportUsersWithProgressV1Server) error {
+	progress, err := a.importUsers(stream.Context(), stream.Recv, stream.Send)
+	if err != nil {
+		return err
+	}
+
+	return stream.Send(progress)
+}
+
+// Импорт пользователей из потока recv через общий сохранитель, буфер которого сбрасывается
+// после каждых chunkSize*parallelism пользователей. После каждого сброса буфера
+// текущий прогресс передается в ack, если он задан. Возвращает итоги импорта
+// после получения результатов сохранения всех пользователей.
+func (a *api) importUsers(
+	ctx context.Context,
+	recv func() (*desc.ImportUsersV1Request, error),
+	ack func(*desc.ImportUsersV1Response) error,
+) (*desc.ImportUsersV1Response, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ImportUsersV1")
+	defer span.Finish()
+
+	// Пользователи потока сохраняются в собственном буфере с контекстом потока,
+	// поэтому в историю попадают инициатор и трасса этого импорта.
+	buffer := a.userSaver.Open(ctx)
+	defer buffer.Close()
+
+	userImporter := importer.NewImporter(a.chunkSize*a.parallelism, buffer)
+
+	for {
+		req, err := recv()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			log.Error().Err(err).Msg("failed to receive users")
+			return nil, err
+		}
+
+		flushed := false
+
+		for _, user := range req.Users {
+			if err := user.ValidateAll(); err != nil {
+				log.Error().Err(err).Msg("invalid user")
+				userImporter.Reject()
+				continue
+			}
+
+			if userImporter.Save(models.User{
+				CalendarId: user.GetCalendarId(),
+				ResumeId:   user.GetResumeId(),
+				Name:       user.GetProfile().GetName(),
+				Surname:    user.GetProfile().GetSurname(),
+				Patronymic: user.GetProfile().GetPatronymic(),
+				Email:      user.GetProfile().GetEmail(),
+			}) {
+				flushed = true
+			}
+		}
+
+		if flushed && ack != nil {
+			if err := ack(importProgress(userImporter.Progress(), false)); err != nil {
+				log.Error().Err(err).Msg("failed to send import progress")
+				return nil, err
+			}
+		}
+	}
+
+	if err := userImporter.Flush(ctx); err != nil {
+		log.Error().Err(err).Msg("failed to wait for imported users")
+		return nil, err
+	}
+
+	progress := userImporter.Progress()
+
+	log.Info().
+		Uint64("received", progress.Received).
+		Uint64("created", progress.Created).
+		Uint64("failed", progress.Failed).
+		Msg("users were imported")
+
+	return importProgress(progress, true), nil
+}
+
+func importProgress(progress importer.Progress, done bool) *desc.ImportUsersV1Response {
+	return &desc.ImportUsersV1Response{
+		Received: progress.Received,
+		Created:  progress.Created,
+		Failed:   progress.Failed,
+		Done:     done,
+	}
+}
+
+func (a *api) MultiUpdateUserV1(
+	ctx context.Context,
+	req *desc.MultiUpdateUserV1Request,
+) (*desc.MultiUpdateUserV1Response, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiUpdateUserV1")
+	defer span.Finish()
 
-		err := a.eventProducer.SendEvent(producer.Event{
-			Type: producer.Updated,
-			Payload: map[string]interface{}{
-				"Id": req.UserId,
+	violations := validationViolations("", req.ValidateAll())
+
+	log.Info().Msgf("update %d users", len(req.Users))
+
+	updates := make([]models.UserUpdate, 0, len(req.Users))
+	seen := make(map[uint64]struct{}, len(req.Users))
+
+	for i, update := range req.Users {
+		if _, exists := seen[update.UserId]; exists {
+			log.Error().Uint64("userId", update.UserId).Msg("invalid argument")
+			return nil, invalidField(fmt.Sprintf("users[%d].userId", i), fmt.Sprintf("duplicate user id %d", update.UserId))
+		}
+
+		seen[update.UserId] = struct{}{}
+
+		fields, err := updateMaskToUserFields(update.UpdateMask.GetPaths())
+		if err != nil {
+			log.Error().Err(err).Msg("invalid argument")
+			return nil, invalidField(fmt.Sprintf("users[%d].updateMask", i), err.Error())
+		}
+
+		violations = maskedViolations(violations, fmt.Sprintf("users[%d].userParams.", i), fields)
+
+		updates = append(updates, models.UserUpdate{
+			User: models.User{
+				Id:         update.UserId,
+				CalendarId: update.UserParams.GetCalendarId(),
+				ResumeId:   update.UserParams.GetResumeId(),
+				Name:       update.UserParams.GetProfile().GetName(),
+				Surname:    update.UserParams.GetProfile().GetSurname(),
+				Patronymic: update.UserParams.GetProfile().GetPatronymic(),
+				Email:      update.UserParams.GetProfile().GetEmail(),
+				Version:    update.ExpectedVersion,
 			},
+			Fields: fields,
 		})
+	}
 
-		if err != nil {
-			log.Error().Err(err).Msg("publish telemetry event")
+	if len(violations) > 0 {
+		log.Error().Int("violations", len(violations)).Msg("invalid argument")
+		return nil, invalidViolations(violations)
+	}
+
+	results, err := a.userRepo.UpdateUsers(ctx, updates, a.chunkSize)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to update users")
+		return nil, repoError(err, 0)
+	}
+
+	response := &desc.MultiUpdateUserV1Response{
+		Results: changeResults(results),
+	}
+
+	for _, result := range results {
+		if result.Err == nil {
+			response.Count++
 		}
 	}
 
-	return &desc.UpdateUserV1Response{
-		Updated: updated,
-	}, nil
+	log.Info().Int64("count", response.Count).Msg("users were updated")
+
+	return response, nil
+}
+
+func (a *api) MultiRemoveUserV1(
+	ctx context.Context,
+	req *desc.MultiRemoveUserV1Request,
+) (*desc.MultiRemoveUserV1Response, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "MultiRemoveUserV1")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
+	}
+
+	log.Info().Msgf("remove %d users", len(req.UserIds))
+
+	results, err := a.userRepo.RemoveUsers(ctx, req.UserIds, a.chunkSize)
+
+	if err != nil {
+		log.Error().Err(err).Msg("failed to remove users")
+		return nil, repoError(err, 0)
+	}
+
+	response := &desc.MultiRemoveUserV1Response{
+		Results: changeResults(results),
+	}
+
+	for _, result := range results {
+		if result.Err == nil {
+			response.Count++
+		}
+	}
+
+	log.Info().Int64("count", response.Count).Msg("users were removed")
+
+	return response, nil
 }
 
 func (a *api) MultiCreateUserV1(
@@ -223,7 +674,7 @@ func (a *api) MultiCreateUserV1(
 	req *desc.MultiCreateUserV1Request,
 ) (*desc.MultiCreateUserV1Response, error) {
 	traceId := uuid.New().String()
-	span, context := opentracing.StartSpanFromContext(ctx, "MultiCreateUserV1")
+	span, spanCtx := opentracing.StartSpanFromContext(ctx, "MultiCreateUserV1")
 	defer span.Finish()
 
 	logger := log.With().
@@ -231,75 +682,253 @@ func (a *api) MultiCreateUserV1(
 		Logger()
 	logger.Info().Msgf("create new %d users", len(req.Users))
 
-	if err := req.Validate(); err != nil {
+	if err := req.ValidateAll(); err != nil {
+		logger.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
+	}
+
+	key, err := idempotencyKey(ctx, req.IdempotencyKey)
+	if err != nil {
 		logger.Error().Err(err).Msg("invalid argument")
-		return nil, status.Error(codes.InvalidArgument, err.Error())
-	}
-
-	users := make([]models.User, 0, len(req.Users))
-	for _, user := range req.Users {
-		users = append(users, models.User{
-			CalendarId: user.CalendarId,
-			ResumeId:   user.ResumeId,
-			Name:       user.Profile.GetName(),
-			Surname:    user.Profile.GetSurname(),
-			Patronymic: user.Profile.GetPatronymic(),
-			Email:      user.Profile.GetEmail(),
+		return nil, err
+	}
+
+	response := &desc.MultiCreateUserV1Response{}
+
+	err = a.idempotent(ctx, "MultiCreateUserV1", key, req, response, func() ([]uint64, error) {
+		users := make([]models.User, 0, len(req.Users))
+		for _, user := range req.Users {
+			users = append(users, models.User{
+				CalendarId: user.CalendarId,
+				ResumeId:   user.ResumeId,
+				Name:       user.Profile.GetName(),
+				Surname:    user.Profile.GetSurname(),
+				Patronymic: user.Profile.GetPatronymic(),
+				Email:      user.Profile.GetEmail(),
+			})
+		}
+
+		if req.Atomic {
+			userIds, err := a.createUsersAtomic(spanCtx, users)
+			if err != nil {
+				logger.Error().Err(err).Msg("failed to create users")
+				return nil, repoError(err, 0)
+			}
+
+			response.Count = int64(len(userIds))
+			response.Results = createdResults(0, userIds)
+			return userIds, nil
+		}
+
+		chunks, err := utils.SplitToChunks(users, a.chunkSize)
+
+		if err != nil {
+			logger.Error().Err(err).Msg("internal error")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+
+		chunkIds := make([][]uint64, len(chunks))
+		attempted := make([]bool, len(chunks))
+
+		// После первой неудачной пачки новые пачки не запускаются, уже запущенные выполняются до конца.
+		scheduleCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		errs := workerpool.Run(scheduleCtx, a.parallelism, len(chunks), func(index int) error {
+			attempted[index] = true
+
+			ids, err := a.createUsers(spanCtx, chunks[index])
+			if err != nil {
+				cancel()
+				return err
+			}
+
+			chunkIds[index] = ids
+			return nil
 		})
+
+		userIds := make([]uint64, 0, len(users))
+		response.Results = make([]*desc.CreateUserResult, 0, len(users))
+
+		for index, chunk := range chunks {
+			first := len(response.Results)
+
+			if errs[index] == nil {
+				response.Results = append(response.Results, createdResults(first, chunkIds[index])...)
+				userIds = append(userIds, chunkIds[index]...)
+				continue
+			}
+
+			var chunkStatus *spb.Status
+
+			switch {
+			case attempted[index]:
+				logger.Error().Err(errs[index]).Msg("failed to create users")
+				chunkStatus = status.Convert(repoError(errs[index], 0)).Proto()
+			case ctx.Err() != nil:
+				chunkStatus = status.Convert(repoError(ctx.Err(), 0)).Proto()
+			default:
+				chunkStatus = status.New(codes.Aborted, "user was not created because another chunk failed").Proto()
+			}
+
+			for i := range chunk {
+				response.Results = append(response.Results, &desc.CreateUserResult{
+					Index:  uint32(first + i),
+					Result: &desc.CreateUserResult_Error{Error: chunkStatus},
+				})
+			}
+		}
+
+		response.Count = int64(len(userIds))
+		return userIds, nil
+	})
+
+	if err != nil {
+		return nil, err
 	}
 
-	chunks, err := utils.SplitToChunks(users, chunkSize)
+	return response, nil
+}
+
+// Создание пачки пользователей в отдельной транзакции.
+func (a *api) createUsers(ctx context.Context, chunk []models.User) ([]uint64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "process batch")
+	defer span.Finish()
+
+	ids, err := a.userRepo.CreateUsers(ctx, chunk)
+	if err != nil {
+		span.LogFields(spanlog.Error(err))
+		ext.Error.Set(span, true)
+	}
+
+	return ids, err
+}
+
+// Создание всех пользователей в одной транзакции.
+func (a *api) createUsersAtomic(ctx context.Context, users []models.User) ([]uint64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "process atomic batch")
+	defer span.Finish()
 
+	ids, err := a.userRepo.CreateUsersAtomic(ctx, users, a.chunkSize)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		span.LogFields(spanlog.Error(err))
+		ext.Error.Set(span, true)
 	}
 
-	count := 0
+	return ids, err
+}
 
-	for _, chunk := range chunks {
-		func(chunk []models.User) {
-			childSpan, _ := opentracing.StartSpanFromContext(context, "process batch")
-			defer childSpan.Finish()
+// Результаты создания пользователей с идентификаторами ids, начиная с индекса first в запросе.
+func createdResults(first int, ids []uint64) []*desc.CreateUserResult {
+	results := make([]*desc.CreateUserResult, 0, len(ids))
 
-			ids, err := a.userRepo.CreateUsers(ctx, chunk)
+	for i, id := range ids {
+		results = append(results, &desc.CreateUserResult{
+			Index:  uint32(first + i),
+			Result: &desc.CreateUserResult_UserId{UserId: id},
+		})
+	}
 
-			if err == nil {
-				count += len(ids)
+	return results
+}
 
-				for _, id := range ids {
-					err := a.eventProducer.SendEvent(producer.Event{
-						Type: producer.Created,
-						Payload: map[string]interface{}{
-							"Id": id,
-						},
-					})
+// Результаты групповой операции: новая версия пользователя или статус ошибки для каждого пользователя.
+func changeResults(results []repo.ChangeResult) []*desc.UserChangeResult {
+	protoResults := make([]*desc.UserChangeResult, 0, len(results))
 
-					if err != nil {
-						logger.Error().Err(err).Msg("publish telemetry event")
-					}
-				}
-			} else {
-				childSpan.LogFields(spanlog.Error(err))
-				ext.Error.Set(childSpan, true)
+	for _, result := range results {
+		protoResult := &desc.UserChangeResult{UserId: result.Id}
 
-				logger.Error().Err(err).Msg("internal error")
+		if result.Err != nil {
+			protoResult.Result = &desc.UserChangeResult_Error{
+				Error: status.Convert(repoError(result.Err, result.Id)).Proto(),
 			}
-		}(chunk)
+		} else {
+			protoResult.Result = &desc.UserChangeResult_Version{Version: result.Version}
+		}
+
+		protoResults = append(protoResults, protoResult)
+	}
+
+	return protoResults
+}
+
+func (a *api) ListUserHistoryV1(
+	ctx context.Context,
+	req *desc.ListUserHistoryV1Request,
+) (*desc.ListUserHistoryV1Response, error) {
+	if err := req.ValidateAll(); err != nil {
+		log.Error().Err(err).Msg("invalid argument")
+		return nil, invalidArgument(err)
+	}
+
+	log.Info().Uint64("userId", req.UserId).Uint64("limit", req.Limit).Msg("list user history")
+
+	var afterId uint64
+
+	if req.PageToken != "" {
+		var err error
+		if afterId, err = a.pageTokens.DecodeHistory(req.PageToken, req.UserId); err != nil {
+			log.Error().Err(err).Msg("invalid page token")
+			return nil, invalidField("pageToken", err.Error())
+		}
+	}
+
+	entries, err := a.userRepo.ListUserHistory(ctx, req.UserId, afterId, req.Limit)
+
+	if err != nil {
+		log.Error().Err(err).Uint64("userId", req.UserId).Msg("failed to list user history")
+		return nil, repoError(err, req.UserId)
+	}
+
+	protoEntries := make([]*desc.UserHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		protoEntries = append(protoEntries, repoHistoryToProtoHistory(&entry))
+	}
+
+	var nextPageToken string
+
+	if len(entries) > 0 && uint64(len(entries)) == req.Limit {
+		nextPageToken, err = a.pageTokens.EncodeHistory(req.UserId, entries[len(entries)-1].Id)
+		if err != nil {
+			log.Error().Err(err).Msg("internal error")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
 	}
 
-	return &desc.MultiCreateUserV1Response{
-		Count: int64(count),
+	log.Info().Msgf("found %d history entries, has next page: %t", len(protoEntries), nextPageToken != "")
+
+	return &desc.ListUserHistoryV1Response{
+		Entries:       protoEntries,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
-func NewOcpUserApi(userRepo repo.Repo, eventProducer producer.Producer) desc.OcpUserApiServer {
+func NewOcpUserApi(
+	userRepo repo.Repo,
+	pageTokens pagetoken.Codec,
+	idempotencyKeys idempotency.Store,
+	userSaver saver.Saver,
+	chunkSize int,
+	parallelism int,
+) desc.OcpUserApiServer {
 	return &api{
-		userRepo:      userRepo,
-		eventProducer: eventProducer,
+		userRepo:        userRepo,
+		userExtractor:   extractor.NewExtractor(chunkSize, userRepo),
+		userSaver:       userSaver,
+		pageTokens:      pageTokens,
+		idempotencyKeys: idempotencyKeys,
+		chunkSize:       chunkSize,
+		parallelism:     parallelism,
 	}
 }
 
 func repoUserToProtoUser(user *models.User) *desc.User {
+	var deletedAt *timestamppb.Timestamp
+	if user.DeletedAt != nil {
+		deletedAt = timestamppb.New(*user.DeletedAt)
+	}
+
 	return &desc.User{
 		Id:         user.Id,
 		CalendarId: user.CalendarId,
@@ -310,5 +939,150 @@ func repoUserToProtoUser(user *models.User) *desc.User {
 			Patronymic: user.Patronymic,
 			Email:      user.Email,
 		},
+		Version:   user.Version,
+		DeletedAt: deletedAt,
+	}
+}
+
+var protoHistoryOperations = map[models.UserOperation]desc.UserHistoryEntry_Operation{
+	models.UserCreated:  desc.UserHistoryEntry_CREATE,
+	models.UserUpdated:  desc.UserHistoryEntry_UPDATE,
+	models.UserRemoved:  desc.UserHistoryEntry_REMOVE,
+	models.UserRestored: desc.UserHistoryEntry_RESTORE,
+}
+
+// Столбцы хранилища, изменения которых записываются в историю, и пути соответствующих полей пользователя
+// в порядке полей пользователя.
+var historyFields = []struct {
+	column string
+	path   string
+}{
+	{"calendar_id", "calendarId"},
+	{"resume_id", "resumeId"},
+	{"name", "profile.name"},
+	{"surname", "profile.surname"},
+	{"patronymic", "profile.patronymic"},
+	{"email", "profile.email"},
+	{"deleted_at", "deletedAt"},
+}
+
+func repoHistoryToProtoHistory(entry *models.UserHistoryEntry) *desc.UserHistoryEntry {
+	changes := make([]*desc.FieldChange, 0, len(entry.Changes))
+
+	for _, field := range historyFields {
+		change, exists := entry.Changes[field.column]
+		if !exists {
+			continue
+		}
+
+		protoChange := &desc.FieldChange{
+			Field: field.path,
+		}
+
+		if change.Before != nil {
+			protoChange.Before = wrapperspb.String(*change.Before)
+		}
+
+		if change.After != nil {
+			protoChange.After = wrapperspb.String(*change.After)
+		}
+
+		changes = append(changes, protoChange)
+	}
+
+	return &desc.UserHistoryEntry{
+		Id:        entry.Id,
+		UserId:    entry.UserId,
+		Operation: protoHistoryOperations[entry.Operation],
+		Actor:     entry.Actor,
+		TraceId:   entry.TraceId,
+		Version:   entry.Version,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+		Changes:   changes,
+	}
+}
+
+// Соответствие путей маски обновления полям пользователя. Пути принимаются как в виде имен полей
+// proto (calendarId), так и в виде, получаемом из JSON-представления маски (calendar_id).
+var updateMaskPaths = map[string][]models.UserField{
+	"calendarId":         {models.UserFieldCalendarId},
+	"calendar_id":        {models.UserFieldCalendarId},
+	"resumeId":           {models.UserFieldResumeId},
+	"resume_id":          {models.UserFieldResumeId},
+	"profile":            {models.UserFieldName, models.UserFieldSurname, models.UserFieldPatronymic, models.UserFieldEmail},
+	"profile.name":       {models.UserFieldName},
+	"profile.surname":    {models.UserFieldSurname},
+	"profile.patronymic": {models.UserFieldPatronymic},
+	"profile.email":      {models.UserFieldEmail},
+}
+
+// Преобразование путей маски обновления в список полей пользователя без повторов.
+// Пустая маска означает обновление всех полей.
+func updateMaskToUserFields(paths []string) ([]models.UserField, error) {
+	if len(paths) == 0 {
+		return models.AllUserFields, nil
+	}
+
+	fields := make([]models.UserField, 0, len(models.AllUserFields))
+	seen := make(map[models.UserField]struct{}, len(models.AllUserFields))
+
+	for _, path := range paths {
+		pathFields, exists := updateMaskPaths[path]
+		if !exists {
+			return nil, fmt.Errorf("unknown update mask path %q", path)
+		}
+
+		for _, field := range pathFields {
+			if _, exists := seen[field]; !exists {
+				seen[field] = struct{}{}
+				fields = append(fields, field)
+			}
+		}
+	}
+
+	return fields, nil
+}
+
+// Исключение нарушений в параметрах пользователя с путями prefix + путь маски, если маска не затрагивает
+// ни одно из полей fields. Остальные нарушения возвращаются без изменений.
+func maskedViolations(
+	violations []*errdetails.BadRequest_FieldViolation,
+	prefix string,
+	fields []models.UserField,
+) []*errdetails.BadRequest_FieldViolation {
+	updated := make(map[models.UserField]struct{}, len(fields))
+	for _, field := range fields {
+		updated[field] = struct{}{}
+	}
+
+	result := make([]*errdetails.BadRequest_FieldViolation, 0, len(violations))
+
+	for _, violation := range violations {
+		pathFields, exists := updateMaskPaths[strings.TrimPrefix(violation.Field, prefix)]
+		if !strings.HasPrefix(violation.Field, prefix) || !exists {
+			result = append(result, violation)
+			continue
+		}
+
+		for _, field := range pathFields {
+			if _, exists := updated[field]; exists {
+				result = append(result, violation)
+				break
+			}
+		}
+	}
+
+	return result
+}
+
+func protoFilterToRepoFilter(filter *desc.UserFilter) models.UserSearchFilter {
+	return models.UserSearchFilter{
+		Name:           filter.GetName(),
+		Surname:        filter.GetSurname(),
+		Patronymic:     filter.GetPatronymic(),
+		Email:          filter.GetEmail(),
+		CalendarId:     filter.GetCalendarId(),
+		ResumeId:       filter.GetResumeId(),
+		IncludeDeleted: filter.GetIncludeDeleted(),
 	}
 }
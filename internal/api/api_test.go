@@ -0,0 +1,1129 @@
+package api_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ozoncp/ocp-user-api/internal/api"
+	"github.com/ozoncp/ocp-user-api/internal/audit"
+	"github.com/ozoncp/ocp-user-api/internal/flusher"
+	"github.com/ozoncp/ocp-user-api/internal/idempotency"
+	"github.com/ozoncp/ocp-user-api/internal/mocks"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/pagetoken"
+	"github.com/ozoncp/ocp-user-api/internal/repo"
+	"github.com/ozoncp/ocp-user-api/internal/saver"
+	desc "github.com/ozoncp/ocp-user-api/pkg/ocp-user-api"
+)
+
+var _ = Describe("Api", func() {
+
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockRepo             *mocks.MockRepo
+		mockIdempotencyStore *mocks.MockIdempotencyStore
+		userSaver            saver.Saver
+
+		server desc.OcpUserApiServer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockRepo = mocks.NewMockRepo(ctrl)
+		mockIdempotencyStore = mocks.NewMockIdempotencyStore(ctrl)
+		// Сохранитель не запускается: буферы сбрасываются только импортом.
+		userSaver = saver.NewSaver(10, mocks.NewMockAlarm(ctrl), flusher.NewFlusher(2, 1, mockRepo))
+
+		server = api.NewOcpUserApi(mockRepo, pagetoken.NewCodec([]byte("secret")), mockIdempotencyStore, userSaver, 2, 1)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Context("describe user", func() {
+
+		It("reports missing user with resource info", func() {
+			mockRepo.EXPECT().GetUser(gomock.Any(), uint64(7)).Return(nil, repo.ErrNotFound)
+
+			_, err := server.DescribeUserV1(ctx, &desc.DescribeUserV1Request{UserId: 7})
+
+			st := status.Convert(err)
+			Expect(st.Code()).Should(Equal(codes.NotFound))
+			Expect(st.Details()).Should(HaveLen(1))
+			Expect(st.Details()[0].(*errdetails.ResourceInfo).ResourceName).Should(Equal("7"))
+		})
+
+		It("suggests retry when storage is unavailable", func() {
+			mockRepo.EXPECT().GetUser(gomock.Any(), uint64(7)).Return(nil, fmt.Errorf("%w: dial tcp", repo.ErrUnavailable))
+
+			_, err := server.DescribeUserV1(ctx, &desc.DescribeUserV1Request{UserId: 7})
+
+			st := status.Convert(err)
+			Expect(st.Code()).Should(Equal(codes.Unavailable))
+			Expect(st.Details()).Should(HaveLen(1))
+			Expect(st.Details()[0]).Should(BeAssignableToTypeOf(&errdetails.RetryInfo{}))
+		})
+
+		It("hides unknown errors", func() {
+			mockRepo.EXPECT().GetUser(gomock.Any(), uint64(7)).Return(nil, errors.New(`relation "users" does not exist`))
+
+			_, err := server.DescribeUserV1(ctx, &desc.DescribeUserV1Request{UserId: 7})
+
+			st := status.Convert(err)
+			Expect(st.Code()).Should(Equal(codes.Internal))
+			Expect(st.Message()).ShouldNot(ContainSubstring("users"))
+		})
+
+		It("reads user state as of given time", func() {
+			asOf := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			mockRepo.EXPECT().GetUserAsOf(gomock.Any(), uint64(7), asOf).Return(&models.User{Id: 7, Name: "Иван", Version: 2}, nil)
+
+			resp, err := server.DescribeUserV1(ctx, &desc.DescribeUserV1Request{UserId: 7, AsOf: timestamppb.New(asOf)})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.User.Profile.Name).Should(Equal("Иван"))
+			Expect(resp.User.Version).Should(BeEquivalentTo(2))
+		})
+
+		It("reports user without complete history", func() {
+			mockRepo.EXPECT().GetUserAsOf(gomock.Any(), uint64(7), gomock.Any()).Return(nil, repo.ErrHistoryIncomplete)
+
+			_, err := server.DescribeUserV1(ctx, &desc.DescribeUserV1Request{UserId: 7, AsOf: timestamppb.Now()})
+
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("rejects invalid point in time", func() {
+			_, err := server.DescribeUserV1(ctx, &desc.DescribeUserV1Request{
+				UserId: 7,
+				AsOf:   &timestamppb.Timestamp{Seconds: 1, Nanos: -1},
+			})
+
+			st := status.Convert(err)
+			Expect(st.Code()).Should(Equal(codes.InvalidArgument))
+			Expect(st.Details()).Should(HaveLen(1))
+			Expect(st.Details()[0].(*errdetails.BadRequest).FieldViolations[0].Field).Should(Equal("asOf"))
+		})
+	})
+
+	Context("list users", func() {
+
+		It("maps filter and sort to search params", func() {
+			mockRepo.EXPECT().SearchUsers(gomock.Any(), models.UserSearchParams{
+				Count: 10,
+				Filter: models.UserSearchFilter{
+					Name:           "Ив",
+					Surname:        "Пет",
+					Patronymic:     "Сер",
+					Email:          "ivan@example.com",
+					CalendarId:     2,
+					ResumeId:       3,
+					IncludeDeleted: true,
+				},
+				SortField: models.SortByEmail,
+				SortDesc:  true,
+			}).Return(&models.UserSearchResult{}, nil)
+
+			_, err := server.ListUsersV1(ctx, &desc.ListUsersV1Request{
+				Limit: 10,
+				Filter: &desc.UserFilter{
+					Name:           "Ив",
+					Surname:        "Пет",
+					Patronymic:     "Сер",
+					Email:          "ivan@example.com",
+					CalendarId:     2,
+					ResumeId:       3,
+					IncludeDeleted: true,
+				},
+				Sort: &desc.UserSort{Field: desc.UserSort_EMAIL, Direction: desc.UserSort_DESC},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("maps every sort field", func() {
+			fields := map[desc.UserSort_Field]models.UserSortField{
+				desc.UserSort_ID:          models.SortById,
+				desc.UserSort_NAME:        models.SortByName,
+				desc.UserSort_SURNAME:     models.SortBySurname,
+				desc.UserSort_PATRONYMIC:  models.SortByPatronymic,
+				desc.UserSort_EMAIL:       models.SortByEmail,
+				desc.UserSort_CALENDAR_ID: models.SortByCalendarId,
+				desc.UserSort_RESUME_ID:   models.SortByResumeId,
+			}
+
+			Expect(fields).Should(HaveLen(len(desc.UserSort_Field_name)))
+
+			for protoField, field := range fields {
+				mockRepo.EXPECT().
+					SearchUsers(gomock.Any(), models.UserSearchParams{Count: 5, SortField: field}).
+					Return(&models.UserSearchResult{}, nil)
+
+				_, err := server.ListUsersV1(ctx, &desc.ListUsersV1Request{
+					Limit: 5,
+					Sort:  &desc.UserSort{Field: protoField},
+				})
+
+				Expect(err).ShouldNot(HaveOccurred())
+			}
+		})
+
+		It("rejects undefined sort field", func() {
+			_, err := server.ListUsersV1(ctx, &desc.ListUsersV1Request{
+				Limit: 5,
+				Sort:  &desc.UserSort{Field: desc.UserSort_Field(100)},
+			})
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("batch describe users", func() {
+
+		It("returns found users and per-id errors in request order", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().GetUsers(gomock.Any(), []uint64{3, 1}).Return([]models.User{{Id: 1, Name: "Иван"}}, nil),
+				mockRepo.EXPECT().GetUsers(gomock.Any(), []uint64{2, 4}).Return(nil, errors.New("connection reset")),
+				mockRepo.EXPECT().GetUsers(gomock.Any(), []uint64{5}).Return([]models.User{{Id: 5, Name: "Петр"}}, nil),
+			)
+
+			resp, err := server.BatchDescribeUsersV1(ctx, &desc.BatchDescribeUsersV1Request{UserIds: []uint64{3, 1, 2, 4, 5}})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Users).Should(HaveLen(2))
+			Expect(resp.Users[0].Id).Should(BeEquivalentTo(1))
+			Expect(resp.Users[0].Profile.Name).Should(Equal("Иван"))
+			Expect(resp.Users[1].Id).Should(BeEquivalentTo(5))
+
+			Expect(resp.Errors).Should(HaveLen(3))
+			Expect(resp.Errors[0].UserId).Should(BeEquivalentTo(3))
+			Expect(resp.Errors[0].Reason).Should(Equal(desc.UserError_NOT_FOUND))
+			Expect(resp.Errors[1].UserId).Should(BeEquivalentTo(2))
+			Expect(resp.Errors[1].Reason).Should(Equal(desc.UserError_INTERNAL))
+			Expect(resp.Errors[1].Message).ShouldNot(ContainSubstring("connection"))
+			Expect(resp.Errors[2].UserId).Should(BeEquivalentTo(4))
+			Expect(resp.Errors[2].Reason).Should(Equal(desc.UserError_INTERNAL))
+		})
+
+		It("rejects empty request", func() {
+			_, err := server.BatchDescribeUsersV1(ctx, &desc.BatchDescribeUsersV1Request{})
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("create user", func() {
+
+		It("reports email conflict", func() {
+			mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(uint64(0), &repo.ConflictError{Field: "email"})
+
+			_, err := server.CreateUserV1(ctx, &desc.CreateUserV1Request{
+				CalendarId: 1,
+				ResumeId:   1,
+				Profile:    userProfile("User@Example.com"),
+			})
+
+			st := status.Convert(err)
+			Expect(st.Code()).Should(Equal(codes.AlreadyExists))
+
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, detail := range st.Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					violations = append(violations, badRequest.FieldViolations...)
+				}
+			}
+
+			Expect(violations).Should(HaveLen(1))
+			Expect(violations[0].Field).Should(Equal("profile.email"))
+		})
+	})
+
+	Context("validate user", func() {
+
+		violationFields := func(err error) []string {
+			var fields []string
+			for _, detail := range status.Convert(err).Details() {
+				if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+					for _, violation := range badRequest.FieldViolations {
+						fields = append(fields, violation.Field)
+					}
+				}
+			}
+
+			return fields
+		}
+
+		It("reports all violations at once", func() {
+			_, err := server.CreateUserV1(ctx, &desc.CreateUserV1Request{
+				Profile: &desc.UserProfile{
+					Surname:    "Иванов2",
+					Patronymic: "Иванович",
+					Email:      "not-an-email",
+				},
+			})
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			// Пустое имя нарушает и минимальную длину, и допустимые символы.
+			Expect(violationFields(err)).Should(ConsistOf(
+				"calendarId", "resumeId", "profile.name", "profile.name", "profile.surname", "profile.email",
+			))
+		})
+
+		It("accepts names with separators", func() {
+			mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(uint64(1), nil)
+
+			_, err := server.CreateUserV1(ctx, &desc.CreateUserV1Request{
+				CalendarId: 1,
+				ResumeId:   1,
+				Profile:    &desc.UserProfile{Name: "Анна-Мария", Surname: "O'Connor", Patronymic: "фон Берг"},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("reports nested paths of batch items", func() {
+			req := &desc.MultiCreateUserV1Request{
+				Users: []*desc.UserParams{userParams("first@example.com"), userParams("second@")},
+			}
+
+			_, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			Expect(violationFields(err)).Should(ConsistOf("users[1].profile.email"))
+		})
+
+		It("limits batch size", func() {
+			req := &desc.MultiCreateUserV1Request{}
+			for i := 0; i < 1001; i++ {
+				req.Users = append(req.Users, userParams(""))
+			}
+
+			_, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(violationFields(err)).Should(ConsistOf("users"))
+		})
+
+		It("validates only updated fields", func() {
+			mockRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), []models.UserField{models.UserFieldEmail}).Return(true, nil)
+
+			_, err := server.UpdateUserV1(ctx, &desc.UpdateUserV1Request{
+				UserId:     1,
+				UserParams: &desc.UserParams{Profile: &desc.UserProfile{Email: "user@example.com"}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"profile.email"}},
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = server.UpdateUserV1(ctx, &desc.UpdateUserV1Request{
+				UserId:     1,
+				UserParams: &desc.UserParams{Profile: &desc.UserProfile{Email: "not-an-email"}},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"profile.email", "calendarId"}},
+			})
+
+			Expect(violationFields(err)).Should(ConsistOf("userParams.profile.email", "userParams.calendarId"))
+		})
+	})
+
+	Context("create user with idempotency key", func() {
+
+		var (
+			req *desc.CreateUserV1Request
+		)
+
+		BeforeEach(func() {
+			req = &desc.CreateUserV1Request{
+				CalendarId:     1,
+				ResumeId:       1,
+				Profile:        userProfile("user@example.com"),
+				IdempotencyKey: "key",
+			}
+		})
+
+		It("stores response of the first request", func() {
+			gomock.InOrder(
+				mockIdempotencyStore.EXPECT().Reserve(gomock.Any(), "CreateUserV1", "key", gomock.Any()).Return(nil, nil),
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(uint64(5), nil),
+				mockIdempotencyStore.EXPECT().
+					Complete(gomock.Any(), "CreateUserV1", "key", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ string, record idempotency.Record) error {
+						Expect(record.UserIds).Should(Equal([]uint64{5}))
+						return nil
+					}),
+			)
+
+			resp, err := server.CreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.UserId).Should(BeEquivalentTo(5))
+		})
+
+		It("replays stored response", func() {
+			stored, _ := proto.Marshal(&desc.CreateUserV1Response{UserId: 5})
+
+			mockIdempotencyStore.EXPECT().
+				Reserve(gomock.Any(), "CreateUserV1", "key", gomock.Any()).
+				Return(&idempotency.Record{Response: stored, UserIds: []uint64{5}}, nil)
+
+			resp, err := server.CreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.UserId).Should(BeEquivalentTo(5))
+		})
+
+		It("takes key from metadata", func() {
+			req.IdempotencyKey = ""
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(idempotency.Header, "header-key"))
+
+			mockIdempotencyStore.EXPECT().
+				Reserve(gomock.Any(), "CreateUserV1", "header-key", gomock.Any()).
+				Return(nil, idempotency.ErrPayloadMismatch)
+
+			_, err := server.CreateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.FailedPrecondition))
+		})
+
+		It("releases key when creation fails", func() {
+			gomock.InOrder(
+				mockIdempotencyStore.EXPECT().Reserve(gomock.Any(), "CreateUserV1", "key", gomock.Any()).Return(nil, nil),
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(uint64(0), repo.ErrUnavailable),
+				mockIdempotencyStore.EXPECT().Release(gomock.Any(), "CreateUserV1", "key").Return(nil),
+			)
+
+			_, err := server.CreateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+		})
+
+		It("releases key when client cancels request", func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+
+			gomock.InOrder(
+				mockIdempotencyStore.EXPECT().Reserve(gomock.Any(), "CreateUserV1", "key", gomock.Any()).Return(nil, nil),
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ *models.User) (uint64, error) {
+						cancel()
+						return 0, fmt.Errorf("%w: %v", repo.ErrTimeout, ctx.Err())
+					}),
+				mockIdempotencyStore.EXPECT().Release(gomock.Any(), "CreateUserV1", "key").
+					DoAndReturn(func(ctx context.Context, _ string, _ string) error {
+						Expect(ctx.Err()).ShouldNot(HaveOccurred())
+						return nil
+					}),
+			)
+
+			_, err := server.CreateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.DeadlineExceeded))
+		})
+
+		It("completes key when client cancels request after creation", func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+
+			gomock.InOrder(
+				mockIdempotencyStore.EXPECT().Reserve(gomock.Any(), "CreateUserV1", "key", gomock.Any()).Return(nil, nil),
+				mockRepo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(context.Context, *models.User) (uint64, error) {
+						cancel()
+						return 5, nil
+					}),
+				mockIdempotencyStore.EXPECT().Complete(gomock.Any(), "CreateUserV1", "key", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, _ string, _ string, _ idempotency.Record) error {
+						Expect(ctx.Err()).ShouldNot(HaveOccurred())
+						return nil
+					}),
+			)
+
+			resp, err := server.CreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.UserId).Should(BeEquivalentTo(5))
+		})
+	})
+
+	Context("multi create users", func() {
+
+		var (
+			req *desc.MultiCreateUserV1Request
+		)
+
+		BeforeEach(func() {
+			req = &desc.MultiCreateUserV1Request{
+				Users: []*desc.UserParams{
+					userParams("first@example.com"),
+					userParams("second@example.com"),
+					userParams("third@example.com"),
+					userParams("fourth@example.com"),
+					userParams("fifth@example.com"),
+				},
+			}
+		})
+
+		It("returns created ids in request order", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return([]uint64{1, 2}, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return([]uint64{3, 4}, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(1)).Return([]uint64{5}, nil),
+			)
+
+			resp, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(5))
+			Expect(resp.Results).Should(HaveLen(5))
+
+			for i, result := range resp.Results {
+				Expect(result.Index).Should(BeEquivalentTo(i))
+				Expect(result.GetUserId()).Should(BeEquivalentTo(i + 1))
+			}
+		})
+
+		It("processes chunks concurrently preserving order", func() {
+			server = api.NewOcpUserApi(mockRepo, pagetoken.NewCodec([]byte("secret")), mockIdempotencyStore, userSaver, 1, 3)
+
+			ids := map[string]uint64{
+				"first@example.com":  1,
+				"second@example.com": 2,
+				"third@example.com":  3,
+				"fourth@example.com": 4,
+				"fifth@example.com":  5,
+			}
+
+			mockRepo.EXPECT().
+				CreateUsers(gomock.Any(), gomock.Len(1)).
+				DoAndReturn(func(_ context.Context, users []models.User) ([]uint64, error) {
+					return []uint64{ids[users[0].Email]}, nil
+				}).
+				Times(5)
+
+			resp, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(5))
+
+			for i, result := range resp.Results {
+				Expect(result.Index).Should(BeEquivalentTo(i))
+				Expect(result.GetUserId()).Should(BeEquivalentTo(i + 1))
+			}
+		})
+
+		It("stops on the first failed chunk", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return([]uint64{1, 2}, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return(nil, &repo.ConflictError{Field: "email"}),
+			)
+
+			resp, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(2))
+
+			codesByIndex := make([]codes.Code, 0, len(resp.Results))
+			for _, result := range resp.Results {
+				codesByIndex = append(codesByIndex, codes.Code(result.GetError().GetCode()))
+			}
+
+			Expect(codesByIndex).Should(Equal([]codes.Code{
+				codes.OK, codes.OK, codes.AlreadyExists, codes.AlreadyExists, codes.Aborted,
+			}))
+		})
+
+		It("completes key when some chunks are created", func() {
+			req.IdempotencyKey = "key"
+
+			gomock.InOrder(
+				mockIdempotencyStore.EXPECT().Reserve(gomock.Any(), "MultiCreateUserV1", "key", gomock.Any()).Return(nil, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return([]uint64{1, 2}, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return(nil, repo.ErrUnavailable),
+				mockIdempotencyStore.EXPECT().
+					Complete(gomock.Any(), "MultiCreateUserV1", "key", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ string, record idempotency.Record) error {
+						Expect(record.UserIds).Should(Equal([]uint64{1, 2}))
+						return nil
+					}),
+			)
+
+			resp, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(2))
+		})
+
+		It("releases key when no chunk is created", func() {
+			req.IdempotencyKey = "key"
+
+			gomock.InOrder(
+				mockIdempotencyStore.EXPECT().Reserve(gomock.Any(), "MultiCreateUserV1", "key", gomock.Any()).Return(nil, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return(nil, repo.ErrUnavailable),
+				mockIdempotencyStore.EXPECT().Release(gomock.Any(), "MultiCreateUserV1", "key").Return(nil),
+			)
+
+			resp, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeZero())
+
+			for _, result := range resp.Results {
+				Expect(result.GetError()).ShouldNot(BeNil())
+			}
+		})
+
+		It("creates all users in one transaction in atomic mode", func() {
+			req.Atomic = true
+
+			mockRepo.EXPECT().CreateUsersAtomic(gomock.Any(), gomock.Len(5), 2).Return([]uint64{1, 2, 3, 4, 5}, nil)
+
+			resp, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(5))
+			Expect(resp.Results).Should(HaveLen(5))
+		})
+
+		It("fails the whole request in atomic mode", func() {
+			req.Atomic = true
+
+			mockRepo.EXPECT().CreateUsersAtomic(gomock.Any(), gomock.Len(5), 2).Return(nil, &repo.ConflictError{Field: "email"})
+
+			_, err := server.MultiCreateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.AlreadyExists))
+		})
+	})
+
+	Context("update user", func() {
+
+		var (
+			req *desc.UpdateUserV1Request
+		)
+
+		BeforeEach(func() {
+			req = &desc.UpdateUserV1Request{
+				UserId:     1,
+				UserParams: userParams("user@example.com"),
+			}
+		})
+
+		It("updates all fields without mask", func() {
+			mockRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), models.AllUserFields).Return(true, nil)
+
+			resp, err := server.UpdateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Updated).Should(BeTrue())
+		})
+
+		It("updates masked fields only", func() {
+			req.UpdateMask = &fieldmaskpb.FieldMask{Paths: []string{"profile.email", "calendar_id", "calendarId"}}
+
+			mockRepo.EXPECT().
+				UpdateUser(gomock.Any(), gomock.Any(), []models.UserField{models.UserFieldEmail, models.UserFieldCalendarId}).
+				Return(true, nil)
+
+			_, err := server.UpdateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("rejects unknown mask paths", func() {
+			req.UpdateMask = &fieldmaskpb.FieldMask{Paths: []string{"profile.phone"}}
+
+			_, err := server.UpdateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("returns new version", func() {
+			req.ExpectedVersion = 3
+
+			mockRepo.EXPECT().
+				UpdateUser(gomock.Any(), gomock.Any(), models.AllUserFields).
+				DoAndReturn(func(_ context.Context, user *models.User, _ []models.UserField) (bool, error) {
+					Expect(user.Version).Should(BeEquivalentTo(3))
+					user.Version = 4
+					return true, nil
+				})
+
+			resp, err := server.UpdateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Version).Should(BeEquivalentTo(4))
+		})
+
+		It("aborts on version mismatch", func() {
+			req.ExpectedVersion = 3
+
+			mockRepo.EXPECT().UpdateUser(gomock.Any(), gomock.Any(), models.AllUserFields).Return(false, repo.ErrVersionMismatch)
+
+			_, err := server.UpdateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.Aborted))
+		})
+	})
+
+	Context("export users", func() {
+
+		It("streams users in batches", func() {
+			mockRepo.EXPECT().
+				ExportUsers(gomock.Any(), gomock.Any(), 2, gomock.Any()).
+				DoAndReturn(func(_ context.Context, params models.UserSearchParams, _ int, fn func([]models.User) error) error {
+					Expect(params.Filter.Name).Should(Equal("iv"))
+					Expect(params.SortField).Should(Equal(models.SortByName))
+
+					if err := fn([]models.User{{Id: 1}, {Id: 2}}); err != nil {
+						return err
+					}
+
+					return fn([]models.User{{Id: 3}})
+				})
+
+			stream := &exportStream{ctx: ctx}
+
+			err := server.ExportUsersV1(&desc.ExportUsersV1Request{
+				Filter: &desc.UserFilter{Name: "iv"},
+				Sort:   &desc.UserSort{Field: desc.UserSort_NAME},
+			}, stream)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.responses).Should(HaveLen(2))
+			Expect(stream.responses[0].Users).Should(HaveLen(2))
+			Expect(stream.responses[1].Users[0].Id).Should(BeEquivalentTo(3))
+		})
+
+		It("stops when client is gone", func() {
+			mockRepo.EXPECT().
+				ExportUsers(gomock.Any(), gomock.Any(), 2, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ models.UserSearchParams, _ int, fn func([]models.User) error) error {
+					return fn([]models.User{{Id: 1}})
+				})
+
+			stream := &exportStream{ctx: ctx, err: status.Error(codes.Canceled, "canceled")}
+
+			err := server.ExportUsersV1(&desc.ExportUsersV1Request{}, stream)
+
+			Expect(status.Code(err)).Should(Equal(codes.Canceled))
+		})
+
+		It("suggests retry when storage is unavailable", func() {
+			mockRepo.EXPECT().ExportUsers(gomock.Any(), gomock.Any(), 2, gomock.Any()).Return(repo.ErrUnavailable)
+
+			err := server.ExportUsersV1(&desc.ExportUsersV1Request{}, &exportStream{ctx: ctx})
+
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+		})
+	})
+
+	Context("import users", func() {
+
+		var (
+			requests []*desc.ImportUsersV1Request
+		)
+
+		BeforeEach(func() {
+			// Буфер импорта вмещает chunkSize * parallelism = 2 пользователя.
+			requests = []*desc.ImportUsersV1Request{
+				{Users: []*desc.UserParams{userParams("1@example.com"), userParams("2@example.com"), userParams("3@example.com")}},
+				{Users: []*desc.UserParams{userParams("4@example.com")}},
+				{Users: []*desc.UserParams{userParams("5@example.com")}},
+			}
+		})
+
+		It("returns import summary", func() {
+			gomock.InOrder(
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return([]uint64{1, 2}, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return(nil, errors.New("db error")),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(1)).Return([]uint64{5}, nil),
+			)
+
+			stream := &importStream{ctx: ctx, requests: requests}
+
+			err := server.ImportUsersV1(stream)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.responses).Should(HaveLen(1))
+			Expect(stream.responses[0].Received).Should(BeEquivalentTo(5))
+			Expect(stream.responses[0].Created).Should(BeEquivalentTo(3))
+			Expect(stream.responses[0].Failed).Should(BeEquivalentTo(2))
+			Expect(stream.responses[0].Done).Should(BeTrue())
+		})
+
+		It("acknowledges progress after each flush", func() {
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, users []models.User) ([]uint64, error) {
+					return make([]uint64, len(users)), nil
+				}).
+				Times(3)
+
+			stream := &importStream{ctx: ctx, requests: requests}
+
+			err := server.ImportUsersWithProgressV1(stream)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(stream.responses).Should(HaveLen(3))
+			Expect(stream.responses[0].Created).Should(BeEquivalentTo(2))
+			Expect(stream.responses[0].Done).Should(BeFalse())
+			Expect(stream.responses[1].Created).Should(BeEquivalentTo(4))
+			Expect(stream.responses[2].Created).Should(BeEquivalentTo(5))
+			Expect(stream.responses[2].Done).Should(BeTrue())
+		})
+
+		It("saves users with the stream context", func() {
+			ctx = audit.WithActor(ctx, "admin")
+
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, users []models.User) ([]uint64, error) {
+					Expect(audit.Actor(ctx)).Should(Equal("admin"))
+					return make([]uint64, len(users)), nil
+				}).
+				Times(3)
+
+			stream := &importStream{ctx: ctx, requests: requests}
+
+			Expect(server.ImportUsersV1(stream)).Should(Succeed())
+		})
+
+		It("fails when stream is broken", func() {
+			// Оставшийся в буфере потока пользователь сохраняется при закрытии буфера.
+			gomock.InOrder(
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(2)).Return([]uint64{1, 2}, nil),
+				mockRepo.EXPECT().CreateUsers(gomock.Any(), gomock.Len(1)).Return([]uint64{3}, nil),
+			)
+
+			stream := &importStream{ctx: ctx, requests: requests[:1], err: status.Error(codes.Canceled, "canceled")}
+
+			err := server.ImportUsersV1(stream)
+
+			Expect(status.Code(err)).Should(Equal(codes.Canceled))
+			Expect(stream.responses).Should(BeEmpty())
+		})
+	})
+
+	Context("multi update users", func() {
+
+		var (
+			req *desc.MultiUpdateUserV1Request
+		)
+
+		BeforeEach(func() {
+			req = &desc.MultiUpdateUserV1Request{
+				Users: []*desc.UserUpdate{
+					{
+						UserId:     1,
+						UserParams: &desc.UserParams{CalendarId: 7, Profile: &desc.UserProfile{}},
+						UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"calendarId"}},
+					},
+					{
+						UserId:          2,
+						UserParams:      &desc.UserParams{CalendarId: 7, Profile: &desc.UserProfile{}},
+						UpdateMask:      &fieldmaskpb.FieldMask{Paths: []string{"calendarId"}},
+						ExpectedVersion: 3,
+					},
+					{
+						UserId:     3,
+						UserParams: userParams("user@example.com"),
+					},
+				},
+			}
+		})
+
+		It("returns per-user results", func() {
+			mockRepo.EXPECT().
+				UpdateUsers(gomock.Any(), gomock.Len(3), 2).
+				DoAndReturn(func(_ context.Context, updates []models.UserUpdate, _ int) ([]repo.ChangeResult, error) {
+					Expect(updates[0].Fields).Should(Equal([]models.UserField{models.UserFieldCalendarId}))
+					Expect(updates[1].User.Version).Should(BeEquivalentTo(3))
+					Expect(updates[2].Fields).Should(Equal(models.AllUserFields))
+
+					return []repo.ChangeResult{
+						{Id: 1, Version: 2},
+						{Id: 2, Err: repo.ErrVersionMismatch},
+						{Id: 3, Err: repo.ErrNotFound},
+					}, nil
+				})
+
+			resp, err := server.MultiUpdateUserV1(ctx, req)
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(1))
+			Expect(resp.Results).Should(HaveLen(3))
+			Expect(resp.Results[0].GetVersion()).Should(BeEquivalentTo(2))
+			Expect(codes.Code(resp.Results[1].GetError().GetCode())).Should(Equal(codes.Aborted))
+			Expect(codes.Code(resp.Results[2].GetError().GetCode())).Should(Equal(codes.NotFound))
+			Expect(resp.Results[2].UserId).Should(BeEquivalentTo(3))
+		})
+
+		It("rejects duplicate user ids", func() {
+			req.Users[2].UserId = 1
+
+			_, err := server.MultiUpdateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("rejects unknown mask paths", func() {
+			req.Users[1].UpdateMask.Paths = []string{"profile.phone"}
+
+			_, err := server.MultiUpdateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("fails the whole request when transaction fails", func() {
+			mockRepo.EXPECT().UpdateUsers(gomock.Any(), gomock.Any(), 2).Return(nil, repo.ErrUnavailable)
+
+			_, err := server.MultiUpdateUserV1(ctx, req)
+
+			Expect(status.Code(err)).Should(Equal(codes.Unavailable))
+		})
+	})
+
+	Context("multi remove users", func() {
+
+		It("returns per-user results", func() {
+			mockRepo.EXPECT().RemoveUsers(gomock.Any(), []uint64{1, 2}, 2).Return([]repo.ChangeResult{
+				{Id: 1, Version: 5},
+				{Id: 2, Err: repo.ErrNotFound},
+			}, nil)
+
+			resp, err := server.MultiRemoveUserV1(ctx, &desc.MultiRemoveUserV1Request{UserIds: []uint64{1, 2}})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Count).Should(BeEquivalentTo(1))
+			Expect(resp.Results[0].GetVersion()).Should(BeEquivalentTo(5))
+			Expect(codes.Code(resp.Results[1].GetError().GetCode())).Should(Equal(codes.NotFound))
+		})
+
+		It("rejects duplicate user ids", func() {
+			_, err := server.MultiRemoveUserV1(ctx, &desc.MultiRemoveUserV1Request{UserIds: []uint64{1, 1}})
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("remove user", func() {
+
+		It("aborts on version mismatch", func() {
+			mockRepo.EXPECT().RemoveUser(gomock.Any(), uint64(1), uint64(2)).Return(false, repo.ErrVersionMismatch)
+
+			_, err := server.RemoveUserV1(ctx, &desc.RemoveUserV1Request{UserId: 1, ExpectedVersion: 2})
+
+			Expect(status.Code(err)).Should(Equal(codes.Aborted))
+		})
+	})
+
+	Context("restore user", func() {
+
+		It("restores deleted user", func() {
+			mockRepo.EXPECT().RestoreUser(gomock.Any(), uint64(1)).Return(true, nil)
+
+			resp, err := server.RestoreUserV1(ctx, &desc.RestoreUserV1Request{UserId: 1})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Restored).Should(BeTrue())
+		})
+	})
+
+	Context("purge deleted users", func() {
+
+		It("purges users deleted before cutoff", func() {
+			deletedBefore := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+			mockRepo.EXPECT().PurgeDeletedUsers(gomock.Any(), deletedBefore).Return(uint64(3), nil)
+
+			resp, err := server.PurgeDeletedUsersV1(ctx, &desc.PurgeDeletedUsersV1Request{
+				DeletedBefore: timestamppb.New(deletedBefore),
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Purged).Should(BeEquivalentTo(3))
+		})
+
+		It("requires cutoff", func() {
+			_, err := server.PurgeDeletedUsersV1(ctx, &desc.PurgeDeletedUsersV1Request{})
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Context("list user history", func() {
+
+		text := func(value string) *string {
+			return &value
+		}
+
+		entries := []models.UserHistoryEntry{
+			{
+				Id:        10,
+				UserId:    1,
+				Operation: models.UserCreated,
+				Actor:     "admin",
+				Version:   1,
+				Changes: map[string]models.FieldChange{
+					"surname": {After: text("Иванов")},
+					"name":    {After: text("Иван")},
+				},
+			},
+			{
+				Id:        12,
+				UserId:    1,
+				Operation: models.UserRemoved,
+				Version:   2,
+				Changes: map[string]models.FieldChange{
+					"deleted_at": {After: text("2021-06-01T00:00:00Z")},
+				},
+			},
+		}
+
+		It("converts entries and pages by last entry", func() {
+			mockRepo.EXPECT().ListUserHistory(gomock.Any(), uint64(1), uint64(0), uint64(2)).Return(entries, nil)
+			mockRepo.EXPECT().ListUserHistory(gomock.Any(), uint64(1), uint64(12), uint64(2)).Return(nil, nil)
+
+			resp, err := server.ListUserHistoryV1(ctx, &desc.ListUserHistoryV1Request{UserId: 1, Limit: 2})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Entries).Should(HaveLen(2))
+			Expect(resp.Entries[0].Operation).Should(Equal(desc.UserHistoryEntry_CREATE))
+			Expect(resp.Entries[0].Actor).Should(Equal("admin"))
+			Expect(resp.Entries[0].Changes).Should(HaveLen(2))
+			Expect(resp.Entries[0].Changes[0].Field).Should(Equal("profile.name"))
+			Expect(resp.Entries[0].Changes[0].Before).Should(BeNil())
+			Expect(resp.Entries[0].Changes[0].After.GetValue()).Should(Equal("Иван"))
+			Expect(resp.Entries[1].Operation).Should(Equal(desc.UserHistoryEntry_REMOVE))
+			Expect(resp.Entries[1].Changes[0].Field).Should(Equal("deletedAt"))
+			Expect(resp.NextPageToken).ShouldNot(BeEmpty())
+
+			resp, err = server.ListUserHistoryV1(ctx, &desc.ListUserHistoryV1Request{
+				UserId:    1,
+				Limit:     2,
+				PageToken: resp.NextPageToken,
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(resp.Entries).Should(BeEmpty())
+			Expect(resp.NextPageToken).Should(BeEmpty())
+		})
+
+		It("rejects page token of another user", func() {
+			mockRepo.EXPECT().ListUserHistory(gomock.Any(), uint64(1), uint64(0), uint64(2)).Return(entries, nil)
+
+			resp, err := server.ListUserHistoryV1(ctx, &desc.ListUserHistoryV1Request{UserId: 1, Limit: 2})
+			Expect(err).ShouldNot(HaveOccurred())
+
+			_, err = server.ListUserHistoryV1(ctx, &desc.ListUserHistoryV1Request{
+				UserId:    2,
+				Limit:     2,
+				PageToken: resp.NextPageToken,
+			})
+
+			Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+		})
+
+		It("requires bounded limit", func() {
+			for _, limit := range []uint64{0, 1001} {
+				_, err := server.ListUserHistoryV1(ctx, &desc.ListUserHistoryV1Request{UserId: 1, Limit: limit})
+
+				Expect(status.Code(err)).Should(Equal(codes.InvalidArgument))
+			}
+		})
+	})
+})
+
+// Поток импорта, возвращающий запросы requests, а затем ошибку err или io.EOF.
+type importStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	requests  []*desc.ImportUsersV1Request
+	err       error
+	responses []*desc.ImportUsersV1Response
+}
+
+func (s *importStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importStream) Recv() (*desc.ImportUsersV1Request, error) {
+	if len(s.requests) == 0 {
+		if s.err != nil {
+			return nil, s.err
+		}
+
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *importStream) Send(resp *desc.ImportUsersV1Response) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func (s *importStream) SendAndClose(resp *desc.ImportUsersV1Response) error {
+	return s.Send(resp)
+}
+
+// Поток выгрузки, сохраняющий отправленные ответы или возвращающий ошибку err.
+type exportStream struct {
+	grpc.ServerStream
+
+	ctx       context.Context
+	err       error
+	responses []*desc.ExportUsersV1Response
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(resp *desc.ExportUsersV1Response) error {
+	if s.err != nil {
+		return s.err
+	}
+
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+func userProfile(email string) *desc.UserProfile {
+	return &desc.UserProfile{
+		Name:    "Иван",
+		Surname: "Иванов",
+		Email:   email,
+	}
+}
+
+func userParams(email string) *desc.UserParams {
+	return &desc.UserParams{
+		CalendarId: 1,
+		ResumeId:   1,
+		Profile:    userProfile(email),
+	}
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/ozoncp/ocp-user-api/internal/repo"
+)
+
+const (
+	// Тип ресурса в деталях ошибок.
+	userResourceType = "ocp.user.api.User"
+	// Задержка повторного запроса при временной недоступности хранилища.
+	retryDelay = time.Second
+)
+
+// Пути полей запроса, соответствующие полям пользователя в хранилище.
+var repoFieldPaths = map[string]string{
+	"calendar_id": "calendarId",
+	"resume_id":   "resumeId",
+	"name":        "profile.name",
+	"surname":     "profile.surname",
+	"patronymic":  "profile.patronymic",
+	"email":       "profile.email",
+	"version":     "expectedVersion",
+}
+
+// Преобразование ошибки хранилища в статус gRPC с деталями google.rpc.
+// Идентификатор userId указывается в деталях ошибки, если он не равен нулю.
+// Текст ошибок неизвестного вида клиенту не передается.
+func repoError(err error, userId uint64) error {
+	var (
+		conflict   *repo.ConflictError
+		constraint *repo.ConstraintError
+	)
+
+	switch {
+	case errors.Is(err, repo.ErrNotFound):
+		return statusWithDetails(codes.NotFound, "user was not found", resourceInfo(userId, err))
+	case errors.Is(err, repo.ErrVersionMismatch):
+		return statusWithDetails(codes.Aborted, err.Error(), resourceInfo(userId, err))
+	case errors.Is(err, repo.ErrHistoryIncomplete):
+		return statusWithDetails(codes.FailedPrecondition, err.Error(), resourceInfo(userId, err))
+	case errors.As(err, &conflict):
+		return statusWithDetails(
+			codes.AlreadyExists,
+			conflict.Error(),
+			resourceInfo(userId, conflict),
+			fieldViolation(fieldPath(conflict.Field), conflict.Error()),
+		)
+	case errors.As(err, &constraint):
+		return statusWithDetails(
+			codes.InvalidArgument,
+			constraint.Error(),
+			fieldViolation(fieldPath(constraint.Field), constraint.Error()),
+		)
+	case errors.Is(err, repo.ErrTimeout):
+		return statusWithDetails(codes.DeadlineExceeded, repo.ErrTimeout.Error(), retryInfo())
+	case errors.Is(err, repo.ErrUnavailable):
+		return statusWithDetails(codes.Unavailable, repo.ErrUnavailable.Error(), retryInfo())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	default:
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// Ошибка валидации запроса. Для ошибок protoc-gen-validate в детали добавляются все нарушенные поля.
+func invalidArgument(err error) error {
+	return invalidViolations(validationViolations("", err))
+}
+
+// Ошибка запроса с нарушениями violations.
+func invalidViolations(violations []*errdetails.BadRequest_FieldViolation) error {
+	descriptions := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		descriptions = append(descriptions, violation.Field+": "+violation.Description)
+	}
+
+	return statusWithDetails(
+		codes.InvalidArgument,
+		"invalid request: "+strings.Join(descriptions, "; "),
+		&errdetails.BadRequest{FieldViolations: violations},
+	)
+}
+
+// Нарушения из ошибки ValidateAll protoc-gen-validate. Вложенные ошибки раскрываются до отдельных полей,
+// пути полей записываются в формате JSON-имен: profile.email, users[1].userId.
+func validationViolations(prefix string, err error) []*errdetails.BadRequest_FieldViolation {
+	var multi interface {
+		AllErrors() []error
+	}
+
+	var violation interface {
+		Field() string
+		Reason() string
+		Cause() error
+	}
+
+	switch {
+	case err == nil:
+		return nil
+
+	case errors.As(err, &multi):
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, item := range multi.AllErrors() {
+			violations = append(violations, validationViolations(prefix, item)...)
+		}
+
+		return violations
+
+	case errors.As(err, &violation):
+		path := jsonFieldPath(violation.Field())
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		if nested := violation.Cause(); nested != nil && (errors.As(nested, &multi) || errors.As(nested, &violation)) {
+			return validationViolations(path, nested)
+		}
+
+		return []*errdetails.BadRequest_FieldViolation{{Field: path, Description: violation.Reason()}}
+
+	default:
+		return []*errdetails.BadRequest_FieldViolation{{Field: prefix, Description: err.Error()}}
+	}
+}
+
+// Преобразование имени поля Go в JSON-имя поля: Users[1] -> users[1].
+func jsonFieldPath(field string) string {
+	if field == "" {
+		return field
+	}
+
+	runes := []rune(field)
+	runes[0] = unicode.ToLower(runes[0])
+
+	return string(runes)
+}
+
+// Ошибка значения поля запроса field.
+func invalidField(field string, description string) error {
+	return statusWithDetails(codes.InvalidArgument, description, fieldViolation(field, description))
+}
+
+func statusWithDetails(code codes.Code, message string, details ...proto.Message) error {
+	st := status.New(code, message)
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
+
+func resourceInfo(userId uint64, err error) *errdetails.ResourceInfo {
+	info := &errdetails.ResourceInfo{
+		ResourceType: userResourceType,
+		Description:  err.Error(),
+	}
+
+	if userId != 0 {
+		info.ResourceName = strconv.FormatUint(userId, 10)
+	}
+
+	return info
+}
+
+func fieldViolation(field string, description string) *errdetails.BadRequest {
+	return &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	}
+}
+
+func retryInfo() *errdetails.RetryInfo {
+	return &errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	}
+}
+
+func fieldPath(field string) string {
+	if path, exists := repoFieldPaths[field]; exists {
+		return path
+	}
+
+	return field
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"github.com/ozoncp/ocp-user-api/internal/idempotency"
+)
+
+// Время на сохранение результата запроса или освобождение его ключа.
+const idempotencyFinishTimeout = 5 * time.Second
+
+// Ключ идемпотентности запроса: значение поля запроса или, если оно пусто, заголовка метаданных.
+func idempotencyKey(ctx context.Context, field string) (string, error) {
+	key := field
+
+	if key == "" {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(idempotency.Header); len(values) > 0 {
+				key = values[0]
+			}
+		}
+	}
+
+	if len(key) > idempotency.MaxKeyLength {
+		return "", invalidField(
+			"idempotencyKey",
+			fmt.Sprintf("idempotency key must not be longer than %d characters", idempotency.MaxKeyLength),
+		)
+	}
+
+	return key, nil
+}
+
+// Хеш содержимого запроса без учета ключа идемпотентности.
+func requestHash(req proto.Message) ([]byte, error) {
+	message := protov2.Clone(proto.MessageV2(req))
+
+	field := message.ProtoReflect().Descriptor().Fields().ByName("idempotencyKey")
+	if field != nil {
+		message.ProtoReflect().Clear(field)
+	}
+
+	data, err := protov2.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(data)
+
+	return sum[:], nil
+}
+
+// Выполнение запроса создания req метода method с ключом идемпотентности key.
+// Если запрос с этим ключом уже выполнен, в resp записывается сохраненный ответ, а call не вызывается.
+// Функция call заполняет resp и возвращает идентификаторы созданных пользователей.
+func (a *api) idempotent(
+	ctx context.Context,
+	method string,
+	key string,
+	req proto.Message,
+	resp proto.Message,
+	call func() ([]uint64, error),
+) error {
+	if key == "" {
+		_, err := call()
+		return err
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		log.Error().Err(err).Msg("internal error")
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	record, err := a.idempotencyKeys.Reserve(ctx, method, key, hash)
+
+	switch {
+	case errors.Is(err, idempotency.ErrPayloadMismatch):
+		log.Error().Err(err).Str("idempotencyKey", key).Msg("failed precondition")
+		return status.Error(codes.FailedPrecondition, err.Error())
+
+	case errors.Is(err, idempotency.ErrInProgress):
+		log.Error().Err(err).Str("idempotencyKey", key).Msg("aborted")
+		return status.Error(codes.Aborted, err.Error())
+
+	case err != nil:
+		log.Error().Err(err).Str("idempotencyKey", key).Msg("failed to reserve idempotency key")
+		return status.Error(codes.Unavailable, "idempotency keys are unavailable")
+	}
+
+	if record != nil {
+		log.Info().Str("idempotencyKey", key).Msg("replay response")
+
+		if err := proto.Unmarshal(record.Response, resp); err != nil {
+			log.Error().Err(err).Str("idempotencyKey", key).Msg("internal error")
+			return status.Error(codes.Internal, "internal error")
+		}
+
+		return nil
+	}
+
+	userIds, err := call()
+
+	// Результат сохраняется и ключ освобождается в отдельном контексте: если клиент отменит запрос,
+	// ключ иначе остался бы в состоянии выполнения до истечения его времени жизни.
+	finishCtx, cancel := context.WithTimeout(context.Background(), idempotencyFinishTimeout)
+	defer cancel()
+
+	// Ключ освобождается, только если ничего не создано, иначе повтор запроса создал бы дубликаты.
+	// Ответ без созданных пользователей, например, когда не сохранена ни одна пачка, не сохраняется,
+	// чтобы повтор запроса мог создать пользователей.
+	if len(userIds) == 0 {
+		if err := a.idempotencyKeys.Release(finishCtx, method, key); err != nil {
+			log.Error().Err(err).Str("idempotencyKey", key).Msg("failed to release idempotency key")
+		}
+
+		return err
+	}
+
+	if err != nil {
+		return err
+	}
+
+	response, err := proto.Marshal(resp)
+	if err == nil {
+		err = a.idempotencyKeys.Complete(finishCtx, method, key, idempotency.Record{Response: response, UserIds: userIds})
+	}
+
+	// Пользователи уже созданы, поэтому ошибка сохранения ответа не возвращается клиенту:
+	// до истечения ключа повторные запросы будут отклоняться как выполняющиеся.
+	if err != nil {
+		log.Error().Err(err).Str("idempotencyKey", key).Msg("failed to complete idempotency key")
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// Заголовок запроса с идентификатором инициатора изменения.
+	ActorHeader = "x-actor"
+	// Максимальная длина идентификатора инициатора, более длинные значения обрезаются.
+	maxActorLength = 256
+)
+
+type actorKey struct{}
+
+// Контекст с инициатором изменений actor.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// Инициатор изменений из контекста или пустая строка, если инициатор неизвестен.
+func Actor(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// Идентификатор трассы текущего спана jaeger или пустая строка, если трасса не ведется.
+func TraceId(ctx context.Context) string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return ""
+	}
+
+	if spanContext, ok := span.Context().(jaeger.SpanContext); ok && spanContext.IsValid() {
+		return spanContext.TraceID().String()
+	}
+
+	return ""
+}
+
+// Перехватчик унарных gRPC-запросов, передающий в контекст инициатора из метаданных запроса.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		return handler(withIncomingActor(ctx), req)
+	}
+}
+
+// Перехватчик потоковых gRPC-запросов, передающий в контекст инициатора из метаданных запроса.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &actorStream{
+			ServerStream: stream,
+			ctx:          withIncomingActor(stream.Context()),
+		})
+	}
+}
+
+type actorStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *actorStream) Context() context.Context {
+	return s.ctx
+}
+
+func withIncomingActor(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	values := md.Get(ActorHeader)
+	if len(values) == 0 {
+		return ctx
+	}
+
+	actor := strings.TrimSpace(values[0])
+	if len([]rune(actor)) > maxActorLength {
+		actor = string([]rune(actor)[:maxActorLength])
+	}
+
+	return WithActor(ctx, actor)
+}
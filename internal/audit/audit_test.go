@@ -0,0 +1,91 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber/jaeger-client-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/ocp.user.api.OcpUserApi/CreateUserV1"}
+
+	cases := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"NoMetadata", context.Background(), ""},
+		{"NoActor", metadata.NewIncomingContext(context.Background(), metadata.Pairs("other", "value")), ""},
+		{"Actor", metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, " admin ")), "admin"},
+		{
+			"LongActor",
+			metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, strings.Repeat("я", maxActorLength+1))),
+			strings.Repeat("я", maxActorLength),
+		},
+	}
+
+	for _, item := range cases {
+		var actual string
+
+		_, _ = interceptor(item.ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			actual = Actor(ctx)
+			return nil, nil
+		})
+
+		if actual != item.expected {
+			t.Errorf("%s: expected actor %q, but got %q", item.name, item.expected, actual)
+		}
+	}
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/ocp.user.api.OcpUserApi/ImportUsersV1"}
+	stream := &serverStream{
+		ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "importer")),
+	}
+
+	var actual string
+
+	_ = interceptor(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+		actual = Actor(stream.Context())
+		return nil
+	})
+
+	if actual != "importer" {
+		t.Errorf("expected actor %q, but got %q", "importer", actual)
+	}
+}
+
+func TestTraceId(t *testing.T) {
+	if actual := TraceId(context.Background()); actual != "" {
+		t.Errorf("expected empty trace id without span, but got %q", actual)
+	}
+
+	tracer, closer := jaeger.NewTracer("test", jaeger.NewConstSampler(true), jaeger.NewNullReporter())
+	defer closer.Close()
+
+	span := tracer.StartSpan("test")
+	defer span.Finish()
+
+	expected := span.Context().(jaeger.SpanContext).TraceID().String()
+
+	if actual := TraceId(opentracing.ContextWithSpan(context.Background(), span)); actual != expected {
+		t.Errorf("expected trace id %q, but got %q", expected, actual)
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
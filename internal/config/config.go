@@ -0,0 +1,351 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	envPrefix = "OCP_USER_API_"
+)
+
+// Конфигурация сервиса. Значения читаются из YAML-файла и могут быть переопределены
+// переменными окружения, имена которых указаны в теге env с префиксом OCP_USER_API_.
+type Config struct {
+	Grpc        Grpc        `yaml:"grpc"`
+	Http        Http        `yaml:"http"`
+	Admin       Admin       `yaml:"admin"`
+	Health      Health      `yaml:"health"`
+	Database    Database    `yaml:"database"`
+	Kafka       Kafka       `yaml:"kafka"`
+	Jaeger      Jaeger      `yaml:"jaeger"`
+	Api         Api         `yaml:"api"`
+	Saver       Saver       `yaml:"saver"`
+	Outbox      Outbox      `yaml:"outbox"`
+	Idempotency Idempotency `yaml:"idempotency"`
+	PageToken   PageToken   `yaml:"pageToken"`
+	Lifecycle   Lifecycle   `yaml:"lifecycle"`
+}
+
+type Grpc struct {
+	Port int `yaml:"port" env:"GRPC_PORT"`
+}
+
+type Http struct {
+	Port int `yaml:"port" env:"HTTP_PORT"`
+}
+
+// Служебный HTTP-сервер: метрики Prometheus и проверки состояния.
+type Admin struct {
+	Port int `yaml:"port" env:"ADMIN_PORT"`
+}
+
+type Health struct {
+	Interval time.Duration `yaml:"interval" env:"HEALTH_INTERVAL"`
+	Timeout  time.Duration `yaml:"timeout" env:"HEALTH_TIMEOUT"`
+}
+
+type Database struct {
+	Driver string `yaml:"driver" env:"DATABASE_DRIVER"`
+	DSN    string `yaml:"dsn" env:"DATABASE_DSN"`
+}
+
+type Kafka struct {
+	Brokers []string `yaml:"brokers" env:"KAFKA_BROKERS"`
+	Topic   string   `yaml:"topic" env:"KAFKA_TOPIC"`
+}
+
+type Jaeger struct {
+	ServiceName  string  `yaml:"serviceName" env:"JAEGER_SERVICE_NAME"`
+	AgentAddress string  `yaml:"agentAddress" env:"JAEGER_AGENT_ADDRESS"`
+	SamplerType  string  `yaml:"samplerType" env:"JAEGER_SAMPLER_TYPE"`
+	SamplerParam float64 `yaml:"samplerParam" env:"JAEGER_SAMPLER_PARAM"`
+	LogSpans     bool    `yaml:"logSpans" env:"JAEGER_LOG_SPANS"`
+}
+
+// Групповые операции: пользователи обрабатываются пачками по ChunkSize, не более Parallelism пачек одновременно.
+type Api struct {
+	ChunkSize   int `yaml:"chunkSize" env:"API_CHUNK_SIZE"`
+	Parallelism int `yaml:"parallelism" env:"API_PARALLELISM"`
+}
+
+type Saver struct {
+	Capacity      int           `yaml:"capacity" env:"SAVER_CAPACITY"`
+	FlushInterval time.Duration `yaml:"flushInterval" env:"SAVER_FLUSH_INTERVAL"`
+	ChunkSize     int           `yaml:"chunkSize" env:"SAVER_CHUNK_SIZE"`
+	Parallelism   int           `yaml:"parallelism" env:"SAVER_PARALLELISM"`
+}
+
+type Outbox struct {
+	BatchSize    int           `yaml:"batchSize" env:"OUTBOX_BATCH_SIZE"`
+	PollInterval time.Duration `yaml:"pollInterval" env:"OUTBOX_POLL_INTERVAL"`
+	MinBackoff   time.Duration `yaml:"minBackoff" env:"OUTBOX_MIN_BACKOFF"`
+	MaxBackoff   time.Duration `yaml:"maxBackoff" env:"OUTBOX_MAX_BACKOFF"`
+}
+
+// Ключи идемпотентности запросов создания: время хранения и период удаления истекших ключей.
+type Idempotency struct {
+	TTL             time.Duration `yaml:"ttl" env:"IDEMPOTENCY_TTL"`
+	CleanupInterval time.Duration `yaml:"cleanupInterval" env:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+}
+
+// Ключ подписи токенов страниц. Значения по умолчанию нет: известный ключ позволил бы подделывать токены.
+type PageToken struct {
+	Secret string `yaml:"secret" env:"PAGE_TOKEN_SECRET"`
+}
+
+type Lifecycle struct {
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" env:"SHUTDOWN_TIMEOUT"`
+}
+
+// Значения по умолчанию для необязательных параметров.
+func Default() Config {
+	return Config{
+		Grpc: Grpc{
+			Port: 7002,
+		},
+		Http: Http{
+			Port: 8080,
+		},
+		Admin: Admin{
+			Port: 9100,
+		},
+		Health: Health{
+			Interval: 5 * time.Second,
+			Timeout:  time.Second,
+		},
+		Database: Database{
+			Driver: "postgres",
+		},
+		Jaeger: Jaeger{
+			ServiceName:  "OcpUserApi",
+			SamplerType:  "const",
+			SamplerParam: 1,
+		},
+		Api: Api{
+			ChunkSize:   10,
+			Parallelism: 4,
+		},
+		Saver: Saver{
+			Capacity:      100,
+			FlushInterval: time.Second,
+			ChunkSize:     10,
+			Parallelism:   4,
+		},
+		Outbox: Outbox{
+			BatchSize:    100,
+			PollInterval: time.Second,
+			MinBackoff:   time.Second,
+			MaxBackoff:   time.Minute,
+		},
+		Idempotency: Idempotency{
+			TTL:             24 * time.Hour,
+			CleanupInterval: time.Hour,
+		},
+		Lifecycle: Lifecycle{
+			ShutdownTimeout: 15 * time.Second,
+		},
+	}
+}
+
+// Загрузка конфигурации: значения по умолчанию, затем файл path (если задан), затем переменные окружения.
+// Загруженная конфигурация не проверяется: сервису и подкомандам нужны разные настройки, поэтому
+// вызывающий код проверяет ее методом Validate, ValidateDatabase или ValidateImport.
+func Load(path string) (*Config, error) {
+	cfg := Default()
+
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := yaml.UnmarshalStrict(data, &cfg); err != nil {
+			return nil, fmt.Errorf("parse config %s: %w", path, err)
+		}
+	}
+
+	if err := applyEnv(reflect.ValueOf(&cfg).Elem()); err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// Нарушения конфигурации, накапливаемые проверками.
+type violations []string
+
+func (v *violations) check(ok bool, format string, args ...interface{}) {
+	if !ok {
+		*v = append(*v, fmt.Sprintf(format, args...))
+	}
+}
+
+func (v violations) err() error {
+	if len(v) > 0 {
+		return errors.New("invalid config: " + strings.Join(v, "; "))
+	}
+
+	return nil
+}
+
+// Проверка конфигурации сервиса. Возвращает все найденные нарушения одной ошибкой.
+func (c *Config) Validate() error {
+	var v violations
+
+	v.check(c.Grpc.Port > 0 && c.Grpc.Port <= 65535, "grpc.port must be in range 1..65535, got %d", c.Grpc.Port)
+	v.check(c.Http.Port > 0 && c.Http.Port <= 65535, "http.port must be in range 1..65535, got %d", c.Http.Port)
+	v.check(c.Http.Port != c.Grpc.Port, "http.port must differ from grpc.port")
+	v.check(c.Admin.Port > 0 && c.Admin.Port <= 65535, "admin.port must be in range 1..65535, got %d", c.Admin.Port)
+	v.check(c.Admin.Port != c.Grpc.Port && c.Admin.Port != c.Http.Port, "admin.port must differ from grpc.port and http.port")
+	v.check(c.Health.Interval > 0, "health.interval must be positive, got %s", c.Health.Interval)
+	v.check(c.Health.Timeout > 0, "health.timeout must be positive, got %s", c.Health.Timeout)
+	c.checkDatabase(&v)
+	v.check(len(c.Kafka.Brokers) > 0, "kafka.brokers must contain at least one broker")
+	v.check(c.Kafka.Topic != "", "kafka.topic is required")
+	v.check(c.Jaeger.ServiceName != "", "jaeger.serviceName is required")
+	v.check(c.Api.ChunkSize > 0, "api.chunkSize must be positive, got %d", c.Api.ChunkSize)
+	v.check(c.Api.Parallelism > 0, "api.parallelism must be positive, got %d", c.Api.Parallelism)
+	c.checkSaver(&v)
+	// Импорт сбрасывает буфер своего потока после каждых api.chunkSize*api.parallelism пользователей.
+	v.check(
+		c.Saver.Capacity >= c.Api.ChunkSize*c.Api.Parallelism,
+		"saver.capacity must not be less than api.chunkSize*api.parallelism, got %d", c.Saver.Capacity,
+	)
+	v.check(c.Outbox.BatchSize > 0, "outbox.batchSize must be positive, got %d", c.Outbox.BatchSize)
+	v.check(c.Outbox.PollInterval > 0, "outbox.pollInterval must be positive, got %s", c.Outbox.PollInterval)
+	v.check(c.Outbox.MinBackoff > 0, "outbox.minBackoff must be positive, got %s", c.Outbox.MinBackoff)
+	v.check(c.Outbox.MaxBackoff >= c.Outbox.MinBackoff, "outbox.maxBackoff must not be less than outbox.minBackoff")
+	v.check(c.Idempotency.TTL > 0, "idempotency.ttl must be positive, got %s", c.Idempotency.TTL)
+	v.check(c.Idempotency.CleanupInterval > 0, "idempotency.cleanupInterval must be positive, got %s", c.Idempotency.CleanupInterval)
+	v.check(c.PageToken.Secret != "", "pageToken.secret is required, set it with %sPAGE_TOKEN_SECRET", envPrefix)
+	v.check(c.Lifecycle.ShutdownTimeout > 0, "lifecycle.shutdownTimeout must be positive, got %s", c.Lifecycle.ShutdownTimeout)
+
+	return v.err()
+}
+
+// Проверка настроек подключения к БД, достаточных для подкоманды migrate.
+func (c *Config) ValidateDatabase() error {
+	var v violations
+	c.checkDatabase(&v)
+
+	return v.err()
+}
+
+// Проверка настроек БД и сохранителя, достаточных для подкоманды import.
+func (c *Config) ValidateImport() error {
+	var v violations
+	c.checkDatabase(&v)
+	c.checkSaver(&v)
+
+	return v.err()
+}
+
+func (c *Config) checkDatabase(v *violations) {
+	v.check(c.Database.Driver != "", "database.driver is required")
+	v.check(c.Database.DSN != "", "database.dsn is required")
+}
+
+func (c *Config) checkSaver(v *violations) {
+	v.check(c.Saver.Capacity > 0, "saver.capacity must be positive, got %d", c.Saver.Capacity)
+	v.check(c.Saver.FlushInterval > 0, "saver.flushInterval must be positive, got %s", c.Saver.FlushInterval)
+	v.check(c.Saver.ChunkSize > 0, "saver.chunkSize must be positive, got %d", c.Saver.ChunkSize)
+	v.check(c.Saver.Parallelism > 0, "saver.parallelism must be positive, got %d", c.Saver.Parallelism)
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+func applyEnv(value reflect.Value) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+		fieldType := value.Type().Field(i)
+
+		if field.Kind() == reflect.Struct {
+			if err := applyEnv(field); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		name, ok := fieldType.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+
+		raw, ok := os.LookupEnv(envPrefix + name)
+		if !ok {
+			continue
+		}
+
+		if err := setValue(field, raw); err != nil {
+			return fmt.Errorf("env %s%s: %w", envPrefix, name, err)
+		}
+	}
+
+	return nil
+}
+
+func setValue(field reflect.Value, raw string) error {
+	if field.Type() == durationType {
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(int64(duration))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+
+	case reflect.Int:
+		number, err := strconv.Atoi(raw)
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(int64(number))
+
+	case reflect.Float64:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+
+		field.SetFloat(number)
+
+	case reflect.Bool:
+		flag, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+
+		field.SetBool(flag)
+
+	case reflect.Slice:
+		items := make([]string, 0)
+		for _, item := range strings.Split(raw, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+
+		field.Set(reflect.ValueOf(items))
+
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "config.yml")
+	content := `
+grpc:
+  port: 8002
+database:
+  dsn: postgres://localhost/ocp
+kafka:
+  brokers: [kafka-1]
+  topic: user
+outbox:
+  pollInterval: 5s
+pageToken:
+  secret: secret
+`
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	os.Setenv(envPrefix+"KAFKA_BROKERS", "kafka-1, kafka-2")
+	os.Setenv(envPrefix+"API_CHUNK_SIZE", "25")
+	defer os.Unsetenv(envPrefix + "KAFKA_BROKERS")
+	defer os.Unsetenv(envPrefix + "API_CHUNK_SIZE")
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if cfg.Grpc.Port != 8002 {
+		t.Errorf("expected grpc port 8002, but got %d", cfg.Grpc.Port)
+	}
+
+	if cfg.Outbox.PollInterval != 5*time.Second {
+		t.Errorf("expected outbox poll interval 5s, but got %s", cfg.Outbox.PollInterval)
+	}
+
+	if expected := []string{"kafka-1", "kafka-2"}; !reflect.DeepEqual(cfg.Kafka.Brokers, expected) {
+		t.Errorf("expected kafka brokers %v, but got %v", expected, cfg.Kafka.Brokers)
+	}
+
+	if cfg.Api.ChunkSize != 25 {
+		t.Errorf("expected api chunk size 25, but got %d", cfg.Api.ChunkSize)
+	}
+
+	if cfg.Saver.Capacity != Default().Saver.Capacity {
+		t.Errorf("expected default saver capacity, but got %d", cfg.Saver.Capacity)
+	}
+}
+
+func TestShippedConfig(t *testing.T) {
+	os.Setenv(envPrefix+"PAGE_TOKEN_SECRET", "secret")
+	defer os.Unsetenv(envPrefix + "PAGE_TOKEN_SECRET")
+
+	cfg, err := Load(filepath.Join("..", "..", "config.yml"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("unexpected validation error %v", err)
+	}
+
+	if cfg.PageToken.Secret != "secret" {
+		t.Errorf("expected page token secret from environment, but got %q", cfg.PageToken.Secret)
+	}
+
+	os.Unsetenv(envPrefix + "PAGE_TOKEN_SECRET")
+
+	cfg, err = Load(filepath.Join("..", "..", "config.yml"))
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "pageToken.secret") {
+		t.Errorf("expected missing page token secret error, but got %v", err)
+	}
+
+	// Подкомандам ключ подписи токенов страниц не нужен.
+	if err := cfg.ValidateDatabase(); err != nil {
+		t.Errorf("migrate: unexpected error %v", err)
+	}
+
+	if err := cfg.ValidateImport(); err != nil {
+		t.Errorf("import: unexpected error %v", err)
+	}
+}
+
+func TestValidateCommands(t *testing.T) {
+	cfg := Default()
+	cfg.Database.DSN = "postgres://localhost/ocp"
+	cfg.Saver.Capacity = 0
+
+	if err := cfg.ValidateDatabase(); err != nil {
+		t.Errorf("expected valid database settings, but got %v", err)
+	}
+
+	if err := cfg.ValidateImport(); err == nil || !strings.Contains(err.Error(), "saver.capacity") {
+		t.Errorf("expected saver capacity error, but got %v", err)
+	}
+
+	cfg.Database.DSN = ""
+
+	if err := cfg.ValidateDatabase(); err == nil || !strings.Contains(err.Error(), "database.dsn") {
+		t.Errorf("expected missing dsn error, but got %v", err)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	cases := []struct {
+		name    string
+		content string
+		env     map[string]string
+		message string
+	}{
+		{"UnknownField", "grpc:\n  address: 1\n", nil, "field address not found"},
+		{"MissingRequired", "grpc:\n  port: 7002\n", nil, "database.dsn is required"},
+		{"InvalidEnv", "grpc:\n  port: 7002\n", map[string]string{"GRPC_PORT": "port"}, "OCP_USER_API_GRPC_PORT"},
+		{"InvalidPort", "grpc:\n  port: 70000\n", nil, "grpc.port must be in range"},
+		{"SmallSaver", "saver:\n  capacity: 10\n", nil, "saver.capacity must not be less than"},
+		{"MissingSecret", "pageToken:\n  secret: \"\"\n", nil, "OCP_USER_API_PAGE_TOKEN_SECRET"},
+	}
+
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	for _, item := range cases {
+		path := filepath.Join(dir, item.name+".yml")
+		if err := ioutil.WriteFile(path, []byte(item.content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		for name, value := range item.env {
+			os.Setenv(envPrefix+name, value)
+		}
+
+		cfg, err := Load(path)
+		if err == nil {
+			err = cfg.Validate()
+		}
+
+		for name := range item.env {
+			os.Unsetenv(envPrefix + name)
+		}
+
+		if err == nil || !strings.Contains(err.Error(), item.message) {
+			t.Errorf("%s: expected error containing %q, but got %v", item.name, item.message, err)
+		}
+	}
+}
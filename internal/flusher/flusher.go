@@ -2,45 +2,128 @@ package flusher
 
 import (
 	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	spanlog "github.com/opentracing/opentracing-go/log"
+	"github.com/rs/zerolog/log"
+
 	"github.com/ozoncp/ocp-user-api/internal/models"
 	"github.com/ozoncp/ocp-user-api/internal/repo"
 	"github.com/ozoncp/ocp-user-api/internal/utils"
+	"github.com/ozoncp/ocp-user-api/internal/workerpool"
 )
 
+// Несохраненный пользователь: индекс в сбрасываемой коллекции и ошибка его сохранения.
+type Failure struct {
+	Index int
+	Err   error
+}
+
 type Flusher interface {
-	Flush(ctx context.Context, users []models.User) int
+	Flush(ctx context.Context, users []models.User) []Failure
 }
 
 func NewFlusher(
 	chunkSize int,
+	parallelism int,
 	userRepo repo.Repo,
 ) Flusher {
 	return &flusher{
-		chunkSize: chunkSize,
-		userRepo:  userRepo,
+		chunkSize:   chunkSize,
+		parallelism: parallelism,
+		userRepo:    userRepo,
 	}
 }
 
 type flusher struct {
-	chunkSize int
-	userRepo  repo.Repo
+	chunkSize   int
+	parallelism int
+	userRepo    repo.Repo
 }
 
-// Сброс коллекции пользователей в БД.
-// Возвращает количество сохраненных сущностей.
-func (f *flusher) Flush(ctx context.Context, users []models.User) int {
-
+// Сброс коллекции пользователей в БД пачками по chunkSize, не более parallelism пачек одновременно.
+// Нарушение уникальности или ограничения одним пользователем откатывает всю пачку, поэтому такая пачка
+// повторно сохраняется по одному пользователю, и отклоняются только нарушающие пользователи со своей ошибкой.
+// Остальные ошибки пачки относятся ко всем ее пользователям.
+// Возвращает несохраненных пользователей в порядке индексов.
+func (f *flusher) Flush(ctx context.Context, users []models.User) []Failure {
 	chunks, err := utils.SplitToChunks(users, f.chunkSize)
 
 	if err != nil {
-		return 0
+		failed := make([]Failure, 0, len(users))
+		for index := range users {
+			failed = append(failed, Failure{Index: index, Err: err})
+		}
+
+		return failed
 	}
 
-	for chunkIndex, chunk := range chunks {
-		if _, err := f.userRepo.CreateUsers(ctx, chunk); err != nil {
-			return chunkIndex * f.chunkSize
+	// Ошибки пользователей пачек, сохраненных повторно по одному пользователю.
+	userErrs := make([][]error, len(chunks))
+
+	errs := workerpool.Run(ctx, f.parallelism, len(chunks), func(index int) error {
+		span, ctx := opentracing.StartSpanFromContext(ctx, "flush chunk")
+		defer span.Finish()
+
+		_, err := f.userRepo.CreateUsers(ctx, chunks[index])
+		if err == nil {
+			return nil
+		}
+
+		span.LogFields(spanlog.Error(err))
+		ext.Error.Set(span, true)
+
+		if isUserError(err) && len(chunks[index]) > 1 {
+			userErrs[index] = f.createEach(ctx, chunks[index])
 		}
+
+		return err
+	})
+
+	var failed []Failure
+
+	for index, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		count := 0
+
+		for offset := range chunks[index] {
+			userErr := err
+			if userErrs[index] != nil {
+				userErr = userErrs[index][offset]
+			}
+
+			if userErr != nil {
+				failed = append(failed, Failure{Index: index*f.chunkSize + offset, Err: userErr})
+				count++
+			}
+		}
+
+		log.Error().Err(err).Int("count", count).Msg("failed to flush users")
+	}
+
+	return failed
+}
+
+// Сохранение пользователей пачки по одному. Возвращает ошибки сохранения в порядке пользователей.
+func (f *flusher) createEach(ctx context.Context, users []models.User) []error {
+	errs := make([]error, len(users))
+
+	for i := range users {
+		_, errs[i] = f.userRepo.CreateUsers(ctx, users[i:i+1])
 	}
 
-	return len(users)
+	return errs
+}
+
+// Ошибка, вызванная значениями отдельного пользователя, а не состоянием хранилища.
+func isUserError(err error) bool {
+	var conflict *repo.ConflictError
+	var constraint *repo.ConstraintError
+
+	return errors.As(err, &conflict) || errors.As(err, &constraint)
 }
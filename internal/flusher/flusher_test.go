@@ -0,0 +1,85 @@
+package flusher_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ozoncp/ocp-user-api/internal/flusher"
+	"github.com/ozoncp/ocp-user-api/internal/mocks"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/repo"
+)
+
+var _ = Describe("Flusher", func() {
+
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockRepo *mocks.MockRepo
+
+		users       []models.User
+		userFlusher flusher.Flusher
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockRepo = mocks.NewMockRepo(ctrl)
+
+		users = []models.User{{Name: "a"}, {Name: "b"}, {Name: "c"}}
+		userFlusher = flusher.NewFlusher(2, 1, mockRepo)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("saves users in chunks", func() {
+		gomock.InOrder(
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[:2]).Return([]uint64{1, 2}, nil),
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[2:]).Return([]uint64{3}, nil),
+		)
+
+		Expect(userFlusher.Flush(ctx, users)).Should(BeEmpty())
+	})
+
+	It("fails all users of the chunk on storage errors", func() {
+		gomock.InOrder(
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[:2]).Return(nil, repo.ErrUnavailable),
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[2:]).Return([]uint64{3}, nil),
+		)
+
+		Expect(userFlusher.Flush(ctx, users)).Should(Equal([]flusher.Failure{
+			{Index: 0, Err: repo.ErrUnavailable},
+			{Index: 1, Err: repo.ErrUnavailable},
+		}))
+	})
+
+	It("saves the chunk user by user on user errors", func() {
+		conflict := &repo.ConflictError{Field: "email"}
+
+		gomock.InOrder(
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[:2]).Return(nil, conflict),
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[:1]).Return([]uint64{1}, nil),
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[1:2]).Return(nil, conflict),
+			mockRepo.EXPECT().CreateUsers(gomock.Any(), users[2:]).Return(nil, &repo.ConstraintError{Field: "name"}),
+		)
+
+		Expect(userFlusher.Flush(ctx, users)).Should(Equal([]flusher.Failure{
+			{Index: 1, Err: conflict},
+			{Index: 2, Err: &repo.ConstraintError{Field: "name"}},
+		}))
+	})
+
+	It("fails all users on invalid chunk size", func() {
+		userFlusher = flusher.NewFlusher(0, 1, mockRepo)
+
+		Expect(userFlusher.Flush(ctx, users)).Should(HaveLen(3))
+	})
+})
@@ -0,0 +1,191 @@
+package gateway
+
+import (
+	"encoding/csv"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/utilities"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/ozoncp/ocp-user-api/pkg/ocp-user-api"
+)
+
+const (
+	exportPath = "/v1/users:export"
+
+	formatNDJSON = "ndjson"
+	formatCSV    = "csv"
+)
+
+var (
+	exportQueryFilter = utilities.NewDoubleArray([][]string{{"format"}})
+
+	csvHeader = []string{"id", "calendarId", "resumeId", "name", "surname", "patronymic", "email", "version", "deletedAt"}
+)
+
+// Выгрузка пользователей по HTTP через ExportUsersV1. Фильтр и сортировка задаются параметрами запроса
+// так же, как для GET /v1/users, формат — параметром format: ndjson (по умолчанию) или csv.
+// Пачки пользователей записываются в ответ по мере получения. Если выгрузка прерывается после начала ответа,
+// соединение разрывается, чтобы клиент не принял неполную выгрузку за полную.
+func exportHandler(mux *runtime.ServeMux, client desc.OcpUserApiClient) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// У ошибок gRPC нет кода, соответствующего 405 Method Not Allowed, поэтому ответ записывается напрямую.
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+
+		format := r.URL.Query().Get("format")
+		if format == "" {
+			format = formatNDJSON
+		}
+
+		if format != formatNDJSON && format != formatCSV {
+			errorHandler(r.Context(), mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "unknown format %q", format))
+			return
+		}
+
+		req := &desc.ExportUsersV1Request{}
+		if err := runtime.PopulateQueryParameters(req, r.URL.Query(), exportQueryFilter); err != nil {
+			errorHandler(r.Context(), mux, outbound, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		ctx, err := runtime.AnnotateContext(r.Context(), mux, r)
+		if err != nil {
+			errorHandler(r.Context(), mux, outbound, w, r, err)
+			return
+		}
+
+		stream, err := client.ExportUsersV1(ctx, req)
+		if err != nil {
+			errorHandler(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		// Ошибка до первой пачки возвращается обычным ответом с кодом ошибки.
+		response, err := stream.Recv()
+		if err != nil && err != io.EOF {
+			errorHandler(ctx, mux, outbound, w, r, err)
+			return
+		}
+
+		var writer usersWriter
+		if format == formatCSV {
+			w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+			writer = newCSVWriter(w)
+		} else {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			writer = &ndjsonWriter{w: w, marshaler: outbound}
+		}
+
+		w.WriteHeader(http.StatusOK)
+
+		// Заголовок записывается до первой пачки, поэтому пустая выгрузка в CSV также содержит заголовок.
+		if err := writer.header(); err != nil {
+			log.Error().Err(err).Msg("failed to write exported users")
+			panic(http.ErrAbortHandler)
+		}
+
+		for err != io.EOF {
+			if err != nil {
+				log.Error().Err(err).Msg("failed to export users")
+				panic(http.ErrAbortHandler)
+			}
+
+			if err := writer.write(response.Users); err != nil {
+				log.Error().Err(err).Msg("failed to write exported users")
+				panic(http.ErrAbortHandler)
+			}
+
+			if flusher, ok := w.(http.Flusher); ok {
+				flusher.Flush()
+			}
+
+			response, err = stream.Recv()
+		}
+	}
+}
+
+type usersWriter interface {
+	header() error
+	write(users []*desc.User) error
+}
+
+// Запись пользователей в формате NDJSON: один JSON-объект пользователя на строку.
+type ndjsonWriter struct {
+	w         io.Writer
+	marshaler runtime.Marshaler
+}
+
+func (n *ndjsonWriter) header() error {
+	return nil
+}
+
+func (n *ndjsonWriter) write(users []*desc.User) error {
+	for _, user := range users {
+		data, err := n.marshaler.Marshal(user)
+		if err != nil {
+			return err
+		}
+
+		if _, err := n.w.Write(append(data, '\n')); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Запись пользователей в формате CSV с заголовком в первой строке.
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCSVWriter(w io.Writer) *csvWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) header() error {
+	if err := c.w.Write(csvHeader); err != nil {
+		return err
+	}
+
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvWriter) write(users []*desc.User) error {
+	for _, user := range users {
+		var deletedAt string
+		if user.DeletedAt != nil {
+			deletedAt = user.DeletedAt.AsTime().Format(time.RFC3339Nano)
+		}
+
+		if err := c.w.Write([]string{
+			strconv.FormatUint(user.Id, 10),
+			strconv.FormatUint(user.CalendarId, 10),
+			strconv.FormatUint(user.ResumeId, 10),
+			user.Profile.GetName(),
+			user.Profile.GetSurname(),
+			user.Profile.GetPatronymic(),
+			user.Profile.GetEmail(),
+			strconv.FormatUint(user.Version, 10),
+			deletedAt,
+		}); err != nil {
+			return err
+		}
+	}
+
+	c.w.Flush()
+	return c.w.Error()
+}
@@ -0,0 +1,197 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	desc "github.com/ozoncp/ocp-user-api/pkg/ocp-user-api"
+)
+
+// Клиент, выгружающий пачки batches, а затем ошибку err или io.EOF.
+type exportClient struct {
+	desc.OcpUserApiClient
+
+	batches [][]*desc.User
+	err     error
+	req     *desc.ExportUsersV1Request
+}
+
+func (c *exportClient) ExportUsersV1(
+	ctx context.Context,
+	req *desc.ExportUsersV1Request,
+	opts ...grpc.CallOption,
+) (desc.OcpUserApi_ExportUsersV1Client, error) {
+	c.req = req
+	return &exportStream{client: c}, nil
+}
+
+type exportStream struct {
+	grpc.ClientStream
+
+	client *exportClient
+}
+
+func (s *exportStream) Recv() (*desc.ExportUsersV1Response, error) {
+	if len(s.client.batches) == 0 {
+		if s.client.err != nil {
+			return nil, s.client.err
+		}
+
+		return nil, io.EOF
+	}
+
+	users := s.client.batches[0]
+	s.client.batches = s.client.batches[1:]
+
+	return &desc.ExportUsersV1Response{Users: users}, nil
+}
+
+func TestExportHandler(t *testing.T) {
+	users := [][]*desc.User{
+		{
+			{Id: 1, CalendarId: 2, Profile: &desc.UserProfile{Name: "Ivan", Email: "ivan@example.com"}, Version: 1},
+			{Id: 2, Profile: &desc.UserProfile{Name: "Petr, Jr."}, Version: 3},
+		},
+		{
+			{Id: 3, Profile: &desc.UserProfile{}, Version: 1},
+		},
+	}
+
+	cases := []struct {
+		query       string
+		empty       bool
+		err         error
+		status      int
+		contentType string
+		body        string
+	}{
+		{
+			query:       "?filter.name=i&sort.field=NAME",
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson",
+			body: `{"id":"1","calendarId":"2","profile":{"name":"Ivan","email":"ivan@example.com"},"version":"1"}` + "\n" +
+				`{"id":"2","profile":{"name":"Petr, Jr."},"version":"3"}` + "\n" +
+				`{"id":"3","profile":{},"version":"1"}` + "\n",
+		},
+		{
+			query:       "?format=csv",
+			status:      http.StatusOK,
+			contentType: "text/csv; charset=utf-8",
+			body: "id,calendarId,resumeId,name,surname,patronymic,email,version,deletedAt\n" +
+				"1,2,0,Ivan,,,ivan@example.com,1,\n" +
+				"2,0,0,\"Petr, Jr.\",,,,3,\n" +
+				"3,0,0,,,,,1,\n",
+		},
+		{
+			query:       "?format=csv&filter.email=none@example.com",
+			empty:       true,
+			status:      http.StatusOK,
+			contentType: "text/csv; charset=utf-8",
+			body:        "id,calendarId,resumeId,name,surname,patronymic,email,version,deletedAt\n",
+		},
+		{
+			query:       "?filter.email=none@example.com",
+			empty:       true,
+			status:      http.StatusOK,
+			contentType: "application/x-ndjson",
+		},
+		{
+			query:  "?format=xml",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "?sort.field=UNKNOWN",
+			status: http.StatusBadRequest,
+		},
+		{
+			query:  "",
+			err:    status.Error(codes.Unavailable, "unavailable"),
+			status: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, item := range cases {
+		client := &exportClient{batches: users, err: item.err}
+		if item.empty || item.err != nil {
+			client.batches = nil
+		}
+
+		handler := exportHandler(runtime.NewServeMux(runtime.WithProtoErrorHandler(errorHandler)), client)
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, exportPath+item.query, nil))
+
+		if recorder.Code != item.status {
+			t.Errorf("%s: expected status %d, but got %d", item.query, item.status, recorder.Code)
+			continue
+		}
+
+		if item.status != http.StatusOK {
+			continue
+		}
+
+		if actual := recorder.Header().Get("Content-Type"); actual != item.contentType {
+			t.Errorf("%s: expected content type %s, but got %s", item.query, item.contentType, actual)
+		}
+
+		if actual := recorder.Body.String(); actual != item.body {
+			t.Errorf("%s: expected body %q, but got %q", item.query, item.body, actual)
+		}
+	}
+}
+
+func TestExportHandlerFilter(t *testing.T) {
+	client := &exportClient{}
+	handler := exportHandler(runtime.NewServeMux(), client)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, exportPath+"?format=csv&filter.email=a@b.c&sort.direction=DESC", nil))
+
+	if client.req.GetFilter().GetEmail() != "a@b.c" || client.req.GetSort().GetDirection() != desc.UserSort_DESC {
+		t.Errorf("unexpected request %v", client.req)
+	}
+}
+
+func TestExportHandlerAbort(t *testing.T) {
+	client := &exportClient{
+		batches: [][]*desc.User{{{Id: 1}}},
+		err:     status.Error(codes.Unavailable, "unavailable"),
+	}
+	handler := exportHandler(runtime.NewServeMux(), client)
+
+	defer func() {
+		if recovered := recover(); recovered != http.ErrAbortHandler {
+			t.Errorf("expected handler abort, but got %v", recovered)
+		}
+	}()
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, exportPath, nil))
+}
+
+func TestExportHandlerMethodNotAllowed(t *testing.T) {
+	client := &exportClient{}
+	handler := exportHandler(runtime.NewServeMux(), client)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, exportPath, nil))
+
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, but got %d", http.StatusMethodNotAllowed, recorder.Code)
+	}
+
+	if allow := recorder.Header().Get("Allow"); allow != http.MethodGet {
+		t.Errorf("expected Allow header %q, but got %q", http.MethodGet, allow)
+	}
+
+	if client.req != nil {
+		t.Errorf("expected no export request, but got %v", client.req)
+	}
+}
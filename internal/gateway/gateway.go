@@ -0,0 +1,144 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/ozoncp/ocp-user-api/internal/audit"
+	"github.com/ozoncp/ocp-user-api/internal/idempotency"
+	desc "github.com/ozoncp/ocp-user-api/pkg/ocp-user-api"
+	"github.com/ozoncp/ocp-user-api/swagger"
+)
+
+const (
+	// Нестандартный код nginx, принятый в google.rpc.Code для отмененных клиентом запросов.
+	statusClientClosedRequest = 499
+
+	swaggerUIPage = `<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>ocp-user-api</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@3/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@3/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function () {
+      SwaggerUIBundle({url: "/swagger.json", dom_id: "#swagger-ui"});
+    };
+  </script>
+</body>
+</html>
+`
+)
+
+// Создание HTTP-обработчика REST API. Запросы к /v1/ проксируются в gRPC-сервер grpcEndpoint,
+// по адресу /v1/users:export выполняется выгрузка пользователей,
+// по адресам /swagger.json и /swagger/ отдаются спецификация и страница Swagger UI.
+func NewHandler(ctx context.Context, grpcEndpoint string) (http.Handler, error) {
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithProtoErrorHandler(errorHandler),
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+	)
+
+	conn, err := grpc.DialContext(ctx, grpcEndpoint, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	if err := desc.RegisterOcpUserApiHandler(ctx, gatewayMux, conn); err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gatewayMux)
+	mux.Handle(exportPath, exportHandler(gatewayMux, desc.NewOcpUserApiClient(conn)))
+	mux.HandleFunc("/swagger.json", serveSwaggerSpec)
+	mux.HandleFunc("/swagger/", serveSwaggerUI)
+
+	return mux, nil
+}
+
+// Передача в метаданные gRPC заголовков ключа идемпотентности и инициатора изменений
+// в дополнение к стандартным заголовкам.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, idempotency.Header) {
+		return idempotency.Header, true
+	}
+
+	if strings.EqualFold(key, audit.ActorHeader) {
+		return audit.ActorHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func serveSwaggerSpec(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(swagger.Spec)
+}
+
+func serveSwaggerUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(swaggerUIPage))
+}
+
+// Преобразование кода gRPC в код HTTP согласно google.rpc.Code.
+func HTTPStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.Canceled:
+		return statusClientClosedRequest
+	default:
+		return runtime.HTTPStatusFromCode(code)
+	}
+}
+
+// Обработчик ошибок gRPC, отличающийся от стандартного только кодами HTTP-ответа.
+func errorHandler(
+	ctx context.Context,
+	mux *runtime.ServeMux,
+	marshaler runtime.Marshaler,
+	w http.ResponseWriter,
+	r *http.Request,
+	err error,
+) {
+	code := codes.Unknown
+	if s, ok := status.FromError(err); ok {
+		code = s.Code()
+	}
+
+	writer := &statusWriter{
+		ResponseWriter: w,
+		from:           runtime.HTTPStatusFromCode(code),
+		to:             HTTPStatusFromCode(code),
+	}
+
+	runtime.DefaultHTTPProtoErrorHandler(ctx, mux, marshaler, writer, r, err)
+}
+
+type statusWriter struct {
+	http.ResponseWriter
+	from int
+	to   int
+}
+
+func (w *statusWriter) WriteHeader(code int) {
+	if code == w.from {
+		code = w.to
+	}
+
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,89 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestHTTPStatusFromCode(t *testing.T) {
+	cases := []struct {
+		code     codes.Code
+		expected int
+	}{
+		{codes.Canceled, statusClientClosedRequest},
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.FailedPrecondition, http.StatusBadRequest},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Internal, http.StatusInternalServerError},
+	}
+
+	for _, item := range cases {
+		if actual := HTTPStatusFromCode(item.code); actual != item.expected {
+			t.Errorf("%s: expected %d, but got %d", item.code, item.expected, actual)
+		}
+	}
+}
+
+func TestSwagger(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	handler, err := NewHandler(ctx, "localhost:0")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	cases := []struct {
+		path        string
+		contentType string
+		body        string
+	}{
+		{"/swagger.json", "application/json", `"swagger": "2.0"`},
+		{"/swagger/", "text/html; charset=utf-8", "SwaggerUIBundle"},
+	}
+
+	for _, item := range cases {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, item.path, nil))
+
+		if recorder.Code != http.StatusOK {
+			t.Errorf("%s: expected status 200, but got %d", item.path, recorder.Code)
+		}
+
+		if actual := recorder.Header().Get("Content-Type"); actual != item.contentType {
+			t.Errorf("%s: expected content type %s, but got %s", item.path, item.contentType, actual)
+		}
+
+		if !strings.Contains(recorder.Body.String(), item.body) {
+			t.Errorf("%s: expected body containing %s", item.path, item.body)
+		}
+	}
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	cases := []struct {
+		header   string
+		expected string
+		ok       bool
+	}{
+		{"Idempotency-Key", "idempotency-key", true},
+		{"X-Actor", "x-actor", true},
+		{"Grpc-Metadata-Trace", "Trace", true},
+		{"Authorization", "grpcgateway-Authorization", true},
+		{"X-Unknown", "", false},
+	}
+
+	for _, item := range cases {
+		actual, ok := headerMatcher(item.header)
+		if actual != item.expected || ok != item.ok {
+			t.Errorf("%s: expected (%q, %t), but got (%q, %t)", item.header, item.expected, item.ok, actual, ok)
+		}
+	}
+}
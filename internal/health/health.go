@@ -0,0 +1,169 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	serviceName = "ocp.user.api.OcpUserApi"
+)
+
+// Проверка готовности зависимости сервиса.
+type Check func(ctx context.Context) error
+
+// Интерфейс предназначен для отслеживания готовности сервиса к обработке запросов.
+// Результат проверок публикуется через стандартный сервис grpc.health.v1 и HTTP-обработчики
+// /healthz (процесс жив) и /readyz (все зависимости доступны и сервис не останавливается).
+type Checker interface {
+	Init(ctx context.Context)
+	// Перевод сервиса в состояние NOT_SERVING перед остановкой.
+	Close()
+	HealthServer() healthpb.HealthServer
+	LivenessHandler() http.Handler
+	ReadinessHandler() http.Handler
+}
+
+func NewChecker(
+	interval time.Duration,
+	timeout time.Duration,
+	checks map[string]Check,
+) Checker {
+	server := health.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	server.SetServingStatus(serviceName, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return &checker{
+		interval: interval,
+		timeout:  timeout,
+		checks:   checks,
+		server:   server,
+		done:     make(chan struct{}),
+		close:    make(chan struct{}),
+	}
+}
+
+type checker struct {
+	sync.RWMutex
+	interval     time.Duration
+	timeout      time.Duration
+	checks       map[string]Check
+	server       *health.Server
+	failures     map[string]string
+	shuttingDown bool
+	done         chan struct{}
+	close        chan struct{}
+}
+
+func (c *checker) Init(ctx context.Context) {
+	c.refresh(ctx)
+	go c.run(ctx)
+}
+
+func (c *checker) run(ctx context.Context) {
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.refresh(ctx)
+
+		case <-c.close:
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Выполнение всех проверок и обновление статуса сервиса.
+func (c *checker) refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	failures := make(map[string]string)
+
+	for name, check := range c.checks {
+		if err := check(ctx); err != nil {
+			failures[name] = err.Error()
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.failures = failures
+
+	if !c.shuttingDown {
+		c.setStatus(len(failures) == 0)
+	}
+}
+
+func (c *checker) setStatus(serving bool) {
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+
+	c.server.SetServingStatus("", status)
+	c.server.SetServingStatus(serviceName, status)
+}
+
+func (c *checker) Close() {
+	c.Lock()
+	c.shuttingDown = true
+	c.server.Shutdown()
+	c.Unlock()
+
+	close(c.close)
+	<-c.done
+}
+
+func (c *checker) HealthServer() healthpb.HealthServer {
+	return c.server
+}
+
+func (c *checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"status": "ok"})
+	})
+}
+
+func (c *checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.RLock()
+		defer c.RUnlock()
+
+		code := http.StatusOK
+		response := map[string]interface{}{"status": "ready"}
+
+		switch {
+		case c.shuttingDown:
+			code = http.StatusServiceUnavailable
+			response["status"] = "shutting down"
+
+		case c.failures == nil || len(c.failures) > 0:
+			code = http.StatusServiceUnavailable
+			response["status"] = "not ready"
+			response["failures"] = c.failures
+		}
+
+		writeJSON(w, code, response)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,73 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func servingStatus(t *testing.T, c Checker) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := c.HealthServer().Check(context.Background(), &healthpb.HealthCheckRequest{Service: serviceName})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	return resp.Status
+}
+
+func readinessCode(c Checker) int {
+	recorder := httptest.NewRecorder()
+	c.ReadinessHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	return recorder.Code
+}
+
+func TestChecker(t *testing.T) {
+	var kafkaErr error
+
+	c := NewChecker(time.Hour, time.Second, map[string]Check{
+		"database": func(ctx context.Context) error { return nil },
+		"kafka":    func(ctx context.Context) error { return kafkaErr },
+	})
+
+	if actual := readinessCode(c); actual != http.StatusServiceUnavailable {
+		t.Errorf("before init: expected %d, but got %d", http.StatusServiceUnavailable, actual)
+	}
+
+	kafkaErr = errors.New("kafka: client has run out of available brokers")
+	c.Init(context.Background())
+
+	if actual := servingStatus(t, c); actual != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("failed check: expected NOT_SERVING, but got %v", actual)
+	}
+
+	if actual := readinessCode(c); actual != http.StatusServiceUnavailable {
+		t.Errorf("failed check: expected %d, but got %d", http.StatusServiceUnavailable, actual)
+	}
+
+	kafkaErr = nil
+	c.(*checker).refresh(context.Background())
+
+	if actual := servingStatus(t, c); actual != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("passed checks: expected SERVING, but got %v", actual)
+	}
+
+	if actual := readinessCode(c); actual != http.StatusOK {
+		t.Errorf("passed checks: expected %d, but got %d", http.StatusOK, actual)
+	}
+
+	c.Close()
+
+	if actual := servingStatus(t, c); actual != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Errorf("shutdown: expected NOT_SERVING, but got %v", actual)
+	}
+
+	if actual := readinessCode(c); actual != http.StatusServiceUnavailable {
+		t.Errorf("shutdown: expected %d, but got %d", http.StatusServiceUnavailable, actual)
+	}
+}
@@ -0,0 +1,76 @@
+package idempotency
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-user-api/internal/alarm"
+)
+
+// Интерфейс фонового удаления истекших ключей идемпотентности.
+type Cleaner interface {
+	Init(ctx context.Context)
+	Close()
+}
+
+func NewCleaner(alarm alarm.Alarm, store Store) Cleaner {
+	return &cleaner{
+		done:  make(chan struct{}),
+		close: make(chan struct{}),
+		alarm: alarm,
+		store: store,
+	}
+}
+
+// Реализация интерфейса Cleaner: по сигналу alarm удаляет истекшие ключи.
+type cleaner struct {
+	done  chan struct{}
+	close chan struct{}
+	alarm alarm.Alarm
+	store Store
+}
+
+func (c *cleaner) Init(ctx context.Context) {
+	go c.run(ctx)
+}
+
+func (c *cleaner) clean(ctx context.Context) {
+	deleted, err := c.store.DeleteExpired(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("delete expired idempotency keys")
+		return
+	}
+
+	if deleted > 0 {
+		log.Debug().Int64("count", deleted).Msg("expired idempotency keys were deleted")
+	}
+}
+
+func (c *cleaner) run(ctx context.Context) {
+	defer close(c.done)
+
+	signal := c.alarm.Alarm()
+
+	for {
+		select {
+		case _, ok := <-signal:
+			if !ok {
+				return
+			}
+
+			c.clean(ctx)
+
+		case <-c.close:
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (c *cleaner) Close() {
+	close(c.close)
+	<-c.done
+}
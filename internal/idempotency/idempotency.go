@@ -0,0 +1,160 @@
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	tableName = "idempotency_keys"
+
+	// Заголовок метаданных gRPC и HTTP, в котором может быть передан ключ идемпотентности.
+	Header = "idempotency-key"
+	// Максимальная длина ключа идемпотентности.
+	MaxKeyLength = 128
+)
+
+var (
+	// Ключ уже использован для запроса с другим содержимым.
+	ErrPayloadMismatch = errors.New("idempotency key was used with a different request")
+	// Запрос с тем же ключом еще выполняется.
+	ErrInProgress = errors.New("request with the same idempotency key is in progress")
+)
+
+// Сохраненный результат запроса.
+type Record struct {
+	Response []byte
+	UserIds  []uint64
+}
+
+// Интерфейс хранилища ключей идемпотентности. Ключ действует в пределах метода method
+// и хранится в течение времени жизни, заданного при создании хранилища.
+type Store interface {
+	// Резервирование ключа за запросом с хешем requestHash. Если ключ свободен или истек, возвращает nil.
+	// Если запрос с этим ключом уже выполнен, возвращает сохраненный результат,
+	// если еще выполняется — ErrInProgress, если содержимое запроса отличается — ErrPayloadMismatch.
+	Reserve(ctx context.Context, method string, key string, requestHash []byte) (*Record, error)
+	// Сохранение результата запроса для зарезервированного ключа.
+	Complete(ctx context.Context, method string, key string, record Record) error
+	// Освобождение ключа запроса, завершившегося ошибкой, чтобы его можно было повторить.
+	Release(ctx context.Context, method string, key string) error
+	// Удаление истекших ключей. Возвращает количество удаленных ключей.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+func NewStore(db *sqlx.DB, ttl time.Duration) Store {
+	return &store{
+		db:  db,
+		ttl: ttl,
+	}
+}
+
+type store struct {
+	db  *sqlx.DB
+	ttl time.Duration
+}
+
+func (s *store) Reserve(ctx context.Context, method string, key string, requestHash []byte) (*Record, error) {
+	// Истекший ключ резервируется заново, как если бы его не было.
+	const reserve = `
+		INSERT INTO ` + tableName + ` (method, key, request_hash, expires_at)
+		VALUES ($1, $2, $3, now() + make_interval(secs => $4))
+		ON CONFLICT (method, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			response = NULL,
+			user_ids = NULL,
+			created_at = now(),
+			expires_at = EXCLUDED.expires_at
+		WHERE ` + tableName + `.expires_at <= now()
+		RETURNING true`
+
+	var reserved bool
+
+	err := s.db.QueryRowxContext(ctx, reserve, method, key, requestHash, s.ttl.Seconds()).Scan(&reserved)
+	if err == nil {
+		return nil, nil
+	}
+
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	const existing = `
+		SELECT request_hash, response, user_ids
+		FROM ` + tableName + `
+		WHERE method = $1 AND key = $2`
+
+	var (
+		hash     []byte
+		response []byte
+		userIds  pq.Int64Array
+	)
+
+	err = s.db.QueryRowxContext(ctx, existing, method, key).Scan(&hash, &response, &userIds)
+	if err == sql.ErrNoRows {
+		// Ключ освобожден параллельным запросом между вставкой и чтением.
+		return nil, ErrInProgress
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(hash, requestHash) {
+		return nil, ErrPayloadMismatch
+	}
+
+	if response == nil {
+		return nil, ErrInProgress
+	}
+
+	record := &Record{
+		Response: response,
+		UserIds:  make([]uint64, 0, len(userIds)),
+	}
+
+	for _, id := range userIds {
+		record.UserIds = append(record.UserIds, uint64(id))
+	}
+
+	return record, nil
+}
+
+func (s *store) Complete(ctx context.Context, method string, key string, record Record) error {
+	userIds := make(pq.Int64Array, 0, len(record.UserIds))
+	for _, id := range record.UserIds {
+		userIds = append(userIds, int64(id))
+	}
+
+	const complete = `
+		UPDATE ` + tableName + `
+		SET response = $3, user_ids = $4
+		WHERE method = $1 AND key = $2`
+
+	_, err := s.db.ExecContext(ctx, complete, method, key, record.Response, userIds)
+	return err
+}
+
+func (s *store) Release(ctx context.Context, method string, key string) error {
+	const release = `
+		DELETE FROM ` + tableName + `
+		WHERE method = $1 AND key = $2 AND response IS NULL`
+
+	_, err := s.db.ExecContext(ctx, release, method, key)
+	return err
+}
+
+func (s *store) DeleteExpired(ctx context.Context) (int64, error) {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM "+tableName+" WHERE expires_at <= now()")
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
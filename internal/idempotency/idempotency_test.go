@@ -0,0 +1,170 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func newMockStore(t *testing.T) (*store, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: unexpected error %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &store{db: sqlx.NewDb(db, "postgres"), ttl: 24 * time.Hour}, mock
+}
+
+func TestReserve(t *testing.T) {
+	const (
+		reserve  = `INSERT INTO idempotency_keys \(method, key, request_hash, expires_at\)`
+		existing = `SELECT request_hash, response, user_ids FROM idempotency_keys WHERE method = \$1 AND key = \$2`
+	)
+
+	hash := []byte("hash")
+	dbError := errors.New("db error")
+	existingColumns := []string{"request_hash", "response", "user_ids"}
+
+	cases := []struct {
+		name     string
+		expect   func(mock sqlmock.Sqlmock)
+		expected *Record
+		err      error
+	}{
+		{
+			name: "Reserved",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(reserve).
+					WithArgs("CreateUserV1", "key", hash, float64(24*60*60)).
+					WillReturnRows(sqlmock.NewRows([]string{"bool"}).AddRow(true))
+			},
+		},
+		{
+			name: "Replay",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(reserve).WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+				mock.ExpectQuery(existing).
+					WithArgs("CreateUserV1", "key").
+					WillReturnRows(sqlmock.NewRows(existingColumns).AddRow(hash, []byte("response"), "{5,6}"))
+			},
+			expected: &Record{Response: []byte("response"), UserIds: []uint64{5, 6}},
+		},
+		{
+			name: "InProgress",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(reserve).WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+				mock.ExpectQuery(existing).WillReturnRows(sqlmock.NewRows(existingColumns).AddRow(hash, nil, nil))
+			},
+			err: ErrInProgress,
+		},
+		{
+			name: "ReleasedConcurrently",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(reserve).WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+				mock.ExpectQuery(existing).WillReturnRows(sqlmock.NewRows(existingColumns))
+			},
+			err: ErrInProgress,
+		},
+		{
+			name: "PayloadMismatch",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(reserve).WillReturnRows(sqlmock.NewRows([]string{"bool"}))
+				mock.ExpectQuery(existing).
+					WillReturnRows(sqlmock.NewRows(existingColumns).AddRow([]byte("other"), []byte("response"), "{5}"))
+			},
+			err: ErrPayloadMismatch,
+		},
+		{
+			name: "ReserveError",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(reserve).WillReturnError(dbError)
+			},
+			err: dbError,
+		},
+	}
+
+	for _, item := range cases {
+		s, mock := newMockStore(t)
+		item.expect(mock)
+
+		actual, err := s.Reserve(context.Background(), "CreateUserV1", "key", hash)
+
+		if !errors.Is(err, item.err) || (item.err == nil && err != nil) {
+			t.Errorf("%s: expected error %v, but got %v", item.name, item.err, err)
+		}
+
+		if !reflect.DeepEqual(actual, item.expected) {
+			t.Errorf("%s: expected record %v, but got %v", item.name, item.expected, actual)
+		}
+
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("%s: %v", item.name, err)
+		}
+	}
+}
+
+func TestComplete(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	mock.ExpectExec(`UPDATE idempotency_keys SET response = \$3, user_ids = \$4 WHERE method = \$1 AND key = \$2`).
+		WithArgs("CreateUserV1", "key", []byte("response"), pq.Int64Array{5, 6}).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := s.Complete(context.Background(), "CreateUserV1", "key", Record{Response: []byte("response"), UserIds: []uint64{5, 6}})
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	// Выполненный запрос не освобождается: его результат должен воспроизводиться до истечения ключа.
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE method = \$1 AND key = \$2 AND response IS NULL`).
+		WithArgs("CreateUserV1", "key").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := s.Release(context.Background(), "CreateUserV1", "key"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeleteExpired(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	mock.ExpectExec(`DELETE FROM idempotency_keys WHERE expires_at <= now\(\)`).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	deleted, err := s.DeleteExpired(context.Background())
+	if err != nil || deleted != 3 {
+		t.Errorf("expected 3 deleted keys, but got %d, %v", deleted, err)
+	}
+
+	mock.ExpectExec("DELETE FROM idempotency_keys").WillReturnError(errors.New("db error"))
+
+	if _, err := s.DeleteExpired(context.Background()); err == nil {
+		t.Error("expected error, but got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,139 @@
+package importer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/saver"
+)
+
+// Прогресс импорта: количество полученных, созданных и несохраненных пользователей.
+type Progress struct {
+	Received uint64
+	Created  uint64
+	Failed   uint64
+}
+
+// Импорт потока пользователей через буфер потока saver.Buffer. Буфер сбрасывается
+// после каждых capacity переданных пользователей и при вызове Flush; результаты сохранения
+// учитываются в Progress по мере их получения. Пользователи, вытесненные из буфера
+// или не сохраненные, учитываются в Progress.Failed.
+// Importer предназначен для обработки одного потока: Save, Reject и Flush не вызываются конкурентно.
+type Importer interface {
+	// Передача пользователя в буфер. Возвращает true, если буфер был сброшен в БД.
+	Save(user models.User) bool
+	// Учет пользователя, не прошедшего проверку.
+	Reject()
+	// Сброс буфера и ожидание результатов сохранения всех переданных пользователей.
+	Flush(ctx context.Context) error
+	Progress() Progress
+}
+
+func NewImporter(
+	capacity int,
+	buffer saver.Buffer,
+) Importer {
+	imp := &importer{
+		capacity: capacity,
+		buffer:   buffer,
+	}
+	imp.saved = sync.NewCond(&imp.mutex)
+
+	return imp
+}
+
+type importer struct {
+	capacity int
+	buffer   saver.Buffer
+	// Количество пользователей, переданных после последнего сброса.
+	unflushed int
+
+	mutex    sync.Mutex
+	saved    *sync.Cond
+	progress Progress
+	// Количество пользователей, ожидающих результата сохранения.
+	pending int
+}
+
+func (i *importer) Save(user models.User) bool {
+	i.mutex.Lock()
+	i.progress.Received++
+	i.pending++
+	i.mutex.Unlock()
+
+	i.buffer.Save(user, i.done)
+
+	i.unflushed++
+	if i.unflushed < i.capacity {
+		return false
+	}
+
+	i.buffer.Flush()
+	i.unflushed = 0
+
+	return true
+}
+
+func (i *importer) done(err error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if err != nil {
+		i.progress.Failed++
+	} else {
+		i.progress.Created++
+	}
+
+	i.pending--
+	i.saved.Broadcast()
+}
+
+func (i *importer) Reject() {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	i.progress.Received++
+	i.progress.Failed++
+}
+
+func (i *importer) Flush(ctx context.Context) error {
+	if i.unflushed > 0 {
+		i.buffer.Flush()
+		i.unflushed = 0
+	}
+
+	// Пользователи, не сохраненные из-за недоступности БД, повторно сохраняются по таймеру сохранителя.
+	stop := make(chan struct{})
+	defer close(stop)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			i.mutex.Lock()
+			i.saved.Broadcast()
+			i.mutex.Unlock()
+		case <-stop:
+		}
+	}()
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	for i.pending > 0 && ctx.Err() == nil {
+		i.saved.Wait()
+	}
+
+	if i.pending > 0 {
+		return ctx.Err()
+	}
+
+	return nil
+}
+
+func (i *importer) Progress() Progress {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	return i.progress
+}
@@ -0,0 +1,13 @@
+package importer_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestImporter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Importer Suite")
+}
@@ -0,0 +1,127 @@
+package importer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ozoncp/ocp-user-api/internal/importer"
+	"github.com/ozoncp/ocp-user-api/internal/mocks"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/saver"
+)
+
+var _ = Describe("Importer", func() {
+
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockBuffer *mocks.MockBuffer
+		pending    []saver.DoneFunc
+
+		userImporter importer.Importer
+	)
+
+	// Буфер откладывает уведомления до сброса; результат каждого сброса задается errs.
+	expectFlush := func(errs ...error) *gomock.Call {
+		return mockBuffer.EXPECT().Flush().Do(func() {
+			for index, done := range pending {
+				done(errs[index])
+			}
+
+			pending = nil
+		})
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockBuffer = mocks.NewMockBuffer(ctrl)
+		pending = nil
+
+		mockBuffer.EXPECT().Save(gomock.Any(), gomock.Any()).
+			Do(func(_ models.User, done saver.DoneFunc) {
+				pending = append(pending, done)
+			}).
+			AnyTimes()
+
+		userImporter = importer.NewImporter(2, mockBuffer)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("flushes buffer after capacity users", func() {
+		expectFlush(nil, nil)
+
+		Expect(userImporter.Save(models.User{Name: "a"})).Should(BeFalse())
+		Expect(userImporter.Save(models.User{Name: "b"})).Should(BeTrue())
+
+		Expect(userImporter.Progress()).Should(Equal(importer.Progress{Received: 2, Created: 2}))
+	})
+
+	It("flushes the rest of users", func() {
+		gomock.InOrder(
+			expectFlush(nil, nil),
+			expectFlush(nil),
+		)
+
+		userImporter.Save(models.User{Name: "a"})
+		userImporter.Save(models.User{Name: "b"})
+		userImporter.Save(models.User{Name: "c"})
+
+		Expect(userImporter.Flush(ctx)).Should(Succeed())
+		Expect(userImporter.Progress()).Should(Equal(importer.Progress{Received: 3, Created: 3}))
+	})
+
+	It("does not flush buffer without new users", func() {
+		Expect(userImporter.Flush(ctx)).Should(Succeed())
+
+		Expect(userImporter.Progress()).Should(Equal(importer.Progress{}))
+	})
+
+	It("counts failed, dropped and rejected users", func() {
+		expectFlush(saver.ErrDropped, errors.New("db error"))
+
+		userImporter.Reject()
+		userImporter.Save(models.User{Name: "a"})
+		userImporter.Save(models.User{Name: "b"})
+
+		Expect(userImporter.Progress()).Should(Equal(importer.Progress{Received: 3, Failed: 3}))
+	})
+
+	It("waits for users retained by buffer", func() {
+		// Буфер оставляет пользователя и сохраняет его позже по таймеру сохранителя.
+		mockBuffer.EXPECT().Flush()
+
+		userImporter.Save(models.User{Name: "a"})
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			pending[0](nil)
+		}()
+
+		Expect(userImporter.Flush(ctx)).Should(Succeed())
+		Expect(userImporter.Progress()).Should(Equal(importer.Progress{Received: 1, Created: 1}))
+	})
+
+	It("stops waiting when context is done", func() {
+		waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		mockBuffer.EXPECT().Flush()
+
+		userImporter.Save(models.User{Name: "a"})
+
+		Expect(userImporter.Flush(waitCtx)).Should(MatchError(context.DeadlineExceeded))
+		Expect(userImporter.Progress()).Should(Equal(importer.Progress{Received: 1}))
+	})
+})
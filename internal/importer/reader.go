@@ -0,0 +1,381 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	desc "github.com/ozoncp/ocp-user-api/pkg/ocp-user-api"
+)
+
+const (
+	FieldCalendarId = "calendarId"
+	FieldResumeId   = "resumeId"
+	FieldName       = "name"
+	FieldSurname    = "surname"
+	FieldPatronymic = "patronymic"
+	FieldEmail      = "email"
+
+	// Максимальная длина строки NDJSON.
+	maxLineLength = 1 << 20
+)
+
+// Поля пользователя, которые могут быть заданы во входном файле.
+var Fields = []string{FieldCalendarId, FieldResumeId, FieldName, FieldSurname, FieldPatronymic, FieldEmail}
+
+// Поля UserParams в ошибках проверки, которые читаются из отдельных столбцов.
+var validatedFields = map[string]string{
+	"CalendarId": FieldCalendarId,
+	"ResumeId":   FieldResumeId,
+}
+
+// Соответствие полей пользователя столбцам CSV или ключам объектов NDJSON.
+// Пустое имя столбца означает, что поле не читается из файла.
+type Mapping map[string]string
+
+// Соответствие по умолчанию: имена столбцов совпадают с именами полей.
+func DefaultMapping() Mapping {
+	mapping := make(Mapping, len(Fields))
+	for _, field := range Fields {
+		mapping[field] = field
+	}
+
+	return mapping
+}
+
+// Разбор соответствия вида field=column[,field=column...]. Не указанные поля читаются из столбцов по умолчанию.
+func ParseMapping(spec string) (Mapping, error) {
+	mapping := DefaultMapping()
+
+	if strings.TrimSpace(spec) == "" {
+		return mapping, nil
+	}
+
+	for _, item := range strings.Split(spec, ",") {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid mapping %q, expected field=column", item)
+		}
+
+		field := strings.TrimSpace(parts[0])
+		if _, exists := mapping[field]; !exists {
+			return nil, fmt.Errorf("unknown field %q, expected one of %s", field, strings.Join(Fields, ", "))
+		}
+
+		mapping[field] = strings.TrimSpace(parts[1])
+	}
+
+	return mapping, nil
+}
+
+// Проверка параметров пользователя из строки файла по правилам UserParams. Поля, не читаемые из файла,
+// при импорте остаются пустыми, поэтому их нарушения не учитываются: пропущенный идентификатор
+// календаря или резюме не делает строку недопустимой.
+func (m Mapping) Validate(user *desc.UserParams) error {
+	err := user.ValidateAll()
+
+	var violations desc.UserParamsMultiError
+	if !errors.As(err, &violations) {
+		return err
+	}
+
+	var mapped desc.UserParamsMultiError
+
+	for _, violation := range violations {
+		var fieldErr desc.UserParamsValidationError
+		if errors.As(violation, &fieldErr) {
+			if field, exists := validatedFields[fieldErr.Field()]; exists && m[field] == "" {
+				continue
+			}
+		}
+
+		mapped = append(mapped, violation)
+	}
+
+	if len(mapped) == 0 {
+		return nil
+	}
+
+	return mapped
+}
+
+// Строка входного файла: номер строки, с которой начинается запись, и параметры пользователя
+// или ошибка разбора записи.
+type Row struct {
+	Line int
+	User *desc.UserParams
+	Err  error
+}
+
+// Построчное чтение пользователей из файла. Ошибки разбора отдельных записей возвращаются в Row.Err,
+// ошибки чтения файла и заголовка прерывают чтение. По окончании файла возвращается io.EOF.
+type Reader interface {
+	Read() (*Row, error)
+}
+
+// Чтение CSV с заголовком в первой непустой строке.
+func NewCSVReader(r io.Reader, mapping Mapping) Reader {
+	return &csvReader{
+		lines:   bufio.NewReader(r),
+		mapping: mapping,
+	}
+}
+
+type csvReader struct {
+	lines   *bufio.Reader
+	mapping Mapping
+	line    int
+	columns map[string]int
+}
+
+func (c *csvReader) Read() (*Row, error) {
+	if c.columns == nil {
+		if err := c.readHeader(); err != nil {
+			return nil, err
+		}
+	}
+
+	line, record, err := c.readRecord()
+
+	var recordErr *recordError
+	if errors.As(err, &recordErr) {
+		return &Row{Line: recordErr.line, Err: recordErr.err}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	row := &Row{Line: line}
+
+	values := make(map[string]string, len(c.columns))
+
+	for field, index := range c.columns {
+		if index >= len(record) {
+			row.Err = fmt.Errorf("expected %d columns, got %d", index+1, len(record))
+			return row, nil
+		}
+
+		values[field] = record[index]
+	}
+
+	row.User, row.Err = userParams(func(field string) (string, error) {
+		return values[field], nil
+	})
+
+	return row, nil
+}
+
+func (c *csvReader) readHeader() error {
+	_, header, err := c.readRecord()
+	if err == io.EOF {
+		return errors.New("missing csv header")
+	}
+
+	if err != nil {
+		return err
+	}
+
+	indexes := make(map[string]int, len(header))
+	for index, column := range header {
+		indexes[strings.TrimSpace(column)] = index
+	}
+
+	c.columns = make(map[string]int, len(c.mapping))
+
+	for field, column := range c.mapping {
+		if column == "" {
+			continue
+		}
+
+		index, exists := indexes[column]
+		if !exists {
+			return fmt.Errorf("column %q of field %s is missing in csv header", column, field)
+		}
+
+		c.columns[field] = index
+	}
+
+	return nil
+}
+
+// Чтение записи CSV, которая может занимать несколько строк, если значения в кавычках содержат переводы строк.
+// Пустые строки пропускаются. Возвращает номер первой строки записи.
+func (c *csvReader) readRecord() (int, []string, error) {
+	var text []byte
+	first := 0
+
+	for {
+		line, err := c.lines.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return 0, nil, err
+		}
+
+		if len(line) > 0 {
+			c.line++
+
+			if len(text) == 0 && len(bytes.TrimSpace(line)) == 0 {
+				if err == io.EOF {
+					return 0, nil, io.EOF
+				}
+
+				continue
+			}
+
+			if len(text) == 0 {
+				first = c.line
+			}
+
+			text = append(text, line...)
+		}
+
+		// Запись закончена, если все кавычки в ней парные.
+		if len(text) > 0 && (bytes.Count(text, []byte{'"'})%2 == 0 || err == io.EOF) {
+			record, parseErr := csv.NewReader(bytes.NewReader(text)).Read()
+			if parseErr != nil {
+				// Номер строки в ошибке csv отсчитывается от начала записи, поэтому используется только причина.
+				var csvErr *csv.ParseError
+				if errors.As(parseErr, &csvErr) {
+					parseErr = csvErr.Err
+				}
+
+				return first, nil, &recordError{line: first, err: parseErr}
+			}
+
+			return first, record, nil
+		}
+
+		if err == io.EOF {
+			return 0, nil, io.EOF
+		}
+	}
+}
+
+// Ошибка разбора одной записи, не прерывающая чтение файла.
+type recordError struct {
+	line int
+	err  error
+}
+
+func (e *recordError) Error() string {
+	return e.err.Error()
+}
+
+// Чтение NDJSON: один JSON-объект пользователя на строку. Пустые строки пропускаются.
+func NewNDJSONReader(r io.Reader, mapping Mapping) Reader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineLength)
+
+	return &ndjsonReader{
+		lines:   scanner,
+		mapping: mapping,
+	}
+}
+
+type ndjsonReader struct {
+	lines   *bufio.Scanner
+	mapping Mapping
+	line    int
+}
+
+func (n *ndjsonReader) Read() (*Row, error) {
+	for n.lines.Scan() {
+		n.line++
+
+		text := bytes.TrimSpace(n.lines.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		row := &Row{Line: n.line}
+
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.UseNumber()
+
+		var object map[string]interface{}
+		if err := decoder.Decode(&object); err != nil {
+			row.Err = fmt.Errorf("invalid json: %w", err)
+			return row, nil
+		}
+
+		row.User, row.Err = userParams(func(field string) (string, error) {
+			key := n.mapping[field]
+			if key == "" {
+				return "", nil
+			}
+
+			switch value := object[key].(type) {
+			case nil:
+				return "", nil
+			case string:
+				return value, nil
+			case json.Number:
+				return value.String(), nil
+			default:
+				return "", fmt.Errorf("%s: expected string or number, got %T", key, value)
+			}
+		})
+
+		return row, nil
+	}
+
+	if err := n.lines.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+// Построение параметров пользователя из значений полей, возвращаемых value.
+func userParams(value func(field string) (string, error)) (*desc.UserParams, error) {
+	values := make(map[string]string, len(Fields))
+
+	for _, field := range Fields {
+		item, err := value(field)
+		if err != nil {
+			return nil, err
+		}
+
+		values[field] = strings.TrimSpace(item)
+	}
+
+	calendarId, err := parseId(FieldCalendarId, values[FieldCalendarId])
+	if err != nil {
+		return nil, err
+	}
+
+	resumeId, err := parseId(FieldResumeId, values[FieldResumeId])
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.UserParams{
+		CalendarId: calendarId,
+		ResumeId:   resumeId,
+		Profile: &desc.UserProfile{
+			Name:       values[FieldName],
+			Surname:    values[FieldSurname],
+			Patronymic: values[FieldPatronymic],
+			Email:      values[FieldEmail],
+		},
+	}, nil
+}
+
+func parseId(field string, value string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid id %q", field, value)
+	}
+
+	return id, nil
+}
@@ -0,0 +1,178 @@
+package importer_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"io"
+	"strings"
+
+	"github.com/ozoncp/ocp-user-api/internal/importer"
+)
+
+var _ = Describe("Reader", func() {
+
+	readAll := func(reader importer.Reader) ([]*importer.Row, error) {
+		var rows []*importer.Row
+
+		for {
+			row, err := reader.Read()
+			if err == io.EOF {
+				return rows, nil
+			}
+
+			if err != nil {
+				return rows, err
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	Context("mapping", func() {
+
+		It("overrides default columns", func() {
+			mapping, err := importer.ParseMapping("email=E-mail, resumeId=")
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(mapping[importer.FieldEmail]).Should(Equal("E-mail"))
+			Expect(mapping[importer.FieldResumeId]).Should(BeEmpty())
+			Expect(mapping[importer.FieldName]).Should(Equal(importer.FieldName))
+		})
+
+		It("rejects unknown fields", func() {
+			_, err := importer.ParseMapping("phone=Phone")
+
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("rejects malformed items", func() {
+			_, err := importer.ParseMapping("email")
+
+			Expect(err).Should(HaveOccurred())
+		})
+	})
+
+	Context("validation", func() {
+
+		read := func(spec string, input string) *importer.Row {
+			mapping, err := importer.ParseMapping(spec)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rows, err := readAll(importer.NewCSVReader(strings.NewReader(input), mapping))
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rows).Should(HaveLen(1))
+			Expect(rows[0].Err).ShouldNot(HaveOccurred())
+
+			return rows[0]
+		}
+
+		It("accepts rows with skipped ids", func() {
+			spec := "calendarId=,resumeId="
+			row := read(spec, "name,surname,patronymic,email\nИван,Иванов,,ivan@example.com\n")
+
+			mapping, _ := importer.ParseMapping(spec)
+			Expect(mapping.Validate(row.User)).Should(Succeed())
+		})
+
+		It("requires mapped ids", func() {
+			row := read("", "calendarId,resumeId,name,surname,patronymic,email\n,7,Иван,Иванов,,ivan@example.com\n")
+
+			err := importer.DefaultMapping().Validate(row.User)
+
+			Expect(err).Should(MatchError(ContainSubstring("CalendarId")))
+			Expect(err).ShouldNot(MatchError(ContainSubstring("ResumeId")))
+		})
+
+		It("reports profile violations of rows with skipped ids", func() {
+			spec := "calendarId=,resumeId="
+			row := read(spec, "name,surname,patronymic,email\nИван,Иванов,,not-an-email\n")
+
+			mapping, _ := importer.ParseMapping(spec)
+			Expect(mapping.Validate(row.User)).Should(MatchError(ContainSubstring("Email")))
+		})
+	})
+
+	Context("csv", func() {
+
+		var (
+			mapping importer.Mapping
+		)
+
+		BeforeEach(func() {
+			var err error
+			mapping, err = importer.ParseMapping("name=Имя,surname=Фамилия,email=Почта,patronymic=,calendarId=,resumeId=")
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("reads rows with line numbers", func() {
+			input := "Фамилия,Имя,Почта\n" +
+				"Иванов,Иван,ivan@example.com\n" +
+				"\n" +
+				"\"Петров\nмл.\",Петр,petr@example.com\n" +
+				"Сидоров,Сидор\n"
+
+			rows, err := readAll(importer.NewCSVReader(strings.NewReader(input), mapping))
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rows).Should(HaveLen(3))
+
+			Expect(rows[0].Line).Should(Equal(2))
+			Expect(rows[0].Err).ShouldNot(HaveOccurred())
+			Expect(rows[0].User.Profile.Name).Should(Equal("Иван"))
+			Expect(rows[0].User.Profile.Surname).Should(Equal("Иванов"))
+			Expect(rows[0].User.Profile.Email).Should(Equal("ivan@example.com"))
+
+			Expect(rows[1].Line).Should(Equal(4))
+			Expect(rows[1].User.Profile.Surname).Should(Equal("Петров\nмл."))
+
+			Expect(rows[2].Line).Should(Equal(6))
+			Expect(rows[2].Err).Should(HaveOccurred())
+		})
+
+		It("requires mapped columns in header", func() {
+			_, err := readAll(importer.NewCSVReader(strings.NewReader("Имя,Почта\nИван,ivan@example.com\n"), mapping))
+
+			Expect(err).Should(MatchError(ContainSubstring("Фамилия")))
+		})
+
+		It("rejects invalid ids", func() {
+			input := "calendarId,resumeId,name,surname,patronymic,email\nabc,1,Иван,Иванов,,ivan@example.com\n"
+
+			rows, err := readAll(importer.NewCSVReader(strings.NewReader(input), importer.DefaultMapping()))
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rows).Should(HaveLen(1))
+			Expect(rows[0].Err).Should(MatchError(ContainSubstring("calendarId")))
+		})
+	})
+
+	Context("ndjson", func() {
+
+		It("reads objects with line numbers", func() {
+			input := `{"calendarId": 5, "name": "Иван", "mail": "ivan@example.com"}` + "\n" +
+				"\n" +
+				`{"name": ["Петр"]}` + "\n" +
+				`not json` + "\n"
+
+			mapping, err := importer.ParseMapping("email=mail")
+			Expect(err).ShouldNot(HaveOccurred())
+
+			rows, err := readAll(importer.NewNDJSONReader(strings.NewReader(input), mapping))
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(rows).Should(HaveLen(3))
+
+			Expect(rows[0].Line).Should(Equal(1))
+			Expect(rows[0].Err).ShouldNot(HaveOccurred())
+			Expect(rows[0].User.CalendarId).Should(BeEquivalentTo(5))
+			Expect(rows[0].User.Profile.Email).Should(Equal("ivan@example.com"))
+
+			Expect(rows[1].Line).Should(Equal(3))
+			Expect(rows[1].Err).Should(HaveOccurred())
+
+			Expect(rows[2].Line).Should(Equal(4))
+			Expect(rows[2].Err).Should(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,118 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Обработчики запуска и остановки компонента приложения. Любой из обработчиков может отсутствовать.
+// OnStart не должен блокироваться: длительная работа выполняется в отдельной горутине,
+// а ее аварийное завершение сообщается через Lifecycle.Fail.
+type Hook struct {
+	Name    string
+	OnStart func(ctx context.Context) error
+	OnStop  func(ctx context.Context) error
+}
+
+// Жизненный цикл приложения: компоненты запускаются в порядке добавления
+// и останавливаются в обратном порядке.
+type Lifecycle struct {
+	shutdownTimeout time.Duration
+	hooks           []Hook
+	failOnce        sync.Once
+	failures        chan error
+}
+
+func New(shutdownTimeout time.Duration) *Lifecycle {
+	return &Lifecycle{
+		shutdownTimeout: shutdownTimeout,
+		failures:        make(chan error, 1),
+	}
+}
+
+func (l *Lifecycle) Append(hook Hook) {
+	l.hooks = append(l.hooks, hook)
+}
+
+// Сообщение об аварийном завершении компонента. Инициирует остановку приложения.
+func (l *Lifecycle) Fail(err error) {
+	l.failOnce.Do(func() {
+		l.failures <- err
+	})
+}
+
+// Запуск приложения. Блокируется до получения SIGINT/SIGTERM, отмены ctx или вызова Fail,
+// после чего останавливает запущенные компоненты. Время остановки ограничено shutdownTimeout.
+func (l *Lifecycle) Run(ctx context.Context) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	started, err := l.start(ctx)
+
+	if err == nil {
+		select {
+		case <-ctx.Done():
+			log.Info().Msg("shutdown requested")
+
+		case err = <-l.failures:
+			log.Error().Err(err).Msg("component failed, shutting down")
+		}
+	}
+
+	stopCtx, cancel := context.WithTimeout(context.Background(), l.shutdownTimeout)
+	defer cancel()
+
+	if stopErr := l.stop(stopCtx, started); err == nil {
+		err = stopErr
+	}
+
+	return err
+}
+
+func (l *Lifecycle) start(ctx context.Context) ([]Hook, error) {
+	started := make([]Hook, 0, len(l.hooks))
+
+	for _, hook := range l.hooks {
+		if hook.OnStart != nil {
+			if err := hook.OnStart(ctx); err != nil {
+				return started, fmt.Errorf("start %s: %w", hook.Name, err)
+			}
+		}
+
+		log.Info().Str("component", hook.Name).Msg("started")
+		started = append(started, hook)
+	}
+
+	return started, nil
+}
+
+func (l *Lifecycle) stop(ctx context.Context, started []Hook) error {
+	var firstErr error
+
+	for i := len(started) - 1; i >= 0; i-- {
+		hook := started[i]
+
+		if hook.OnStop != nil {
+			if err := hook.OnStop(ctx); err != nil {
+				log.Error().Err(err).Str("component", hook.Name).Msg("failed to stop")
+
+				if firstErr == nil {
+					firstErr = fmt.Errorf("stop %s: %w", hook.Name, err)
+				}
+
+				continue
+			}
+		}
+
+		log.Info().Str("component", hook.Name).Msg("stopped")
+	}
+
+	return firstErr
+}
@@ -0,0 +1,88 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func recordingHook(name string, events *[]string, startErr error) Hook {
+	return Hook{
+		Name: name,
+		OnStart: func(ctx context.Context) error {
+			*events = append(*events, "start "+name)
+			return startErr
+		},
+		OnStop: func(ctx context.Context) error {
+			*events = append(*events, "stop "+name)
+			return nil
+		},
+	}
+}
+
+func TestRunStopsInReverseOrder(t *testing.T) {
+	var events []string
+
+	app := New(time.Second)
+	app.Append(recordingHook("db", &events, nil))
+	app.Append(recordingHook("server", &events, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := app.Run(ctx); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := []string{"start db", "start server", "stop server", "stop db"}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %v, but got %v", expected, events)
+	}
+}
+
+func TestRunStopsStartedOnStartFailure(t *testing.T) {
+	var events []string
+	startErr := errors.New("port is busy")
+
+	app := New(time.Second)
+	app.Append(recordingHook("db", &events, nil))
+	app.Append(recordingHook("server", &events, startErr))
+	app.Append(recordingHook("gateway", &events, nil))
+
+	err := app.Run(context.Background())
+	if !errors.Is(err, startErr) {
+		t.Errorf("expected error %v, but got %v", startErr, err)
+	}
+
+	expected := []string{"start db", "start server", "stop db"}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %v, but got %v", expected, events)
+	}
+}
+
+func TestRunStopsOnFail(t *testing.T) {
+	var events []string
+	serveErr := errors.New("connection reset")
+
+	app := New(time.Second)
+	app.Append(recordingHook("db", &events, nil))
+	app.Append(Hook{
+		Name: "server",
+		OnStart: func(ctx context.Context) error {
+			go app.Fail(serveErr)
+			return nil
+		},
+	})
+
+	err := app.Run(context.Background())
+	if err != serveErr {
+		t.Errorf("expected error %v, but got %v", serveErr, err)
+	}
+
+	expected := []string{"start db", "stop db"}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected %v, but got %v", expected, events)
+	}
+}
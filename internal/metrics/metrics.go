@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	namespace = "ocp_user_api"
+)
+
+var (
+	grpcRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Number of handled gRPC requests by method and status code.",
+	}, []string{"method", "code"})
+
+	grpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of gRPC requests by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	repoDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "repo",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of repository queries by method and result.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "status"})
+
+	eventsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "producer",
+		Name:      "events_total",
+		Help:      "Number of events sent to the broker by type and result.",
+	}, []string{"type", "status"})
+
+	saverBufferSize = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "saver",
+		Name:      "buffer_size",
+		Help:      "Number of users waiting in the saver buffers.",
+	})
+
+	saverDropped = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "saver",
+		Name:      "dropped_users_total",
+		Help:      "Number of users dropped from the full saver buffer.",
+	})
+)
+
+// HTTP-обработчик, отдающий метрики в формате Prometheus.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// Перехватчик унарных gRPC-запросов, учитывающий количество и длительность запросов.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeGrpc(info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// Перехватчик потоковых gRPC-запросов, учитывающий количество и длительность запросов.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		observeGrpc(info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+func observeGrpc(fullMethod string, start time.Time, err error) {
+	method := path.Base(fullMethod)
+
+	grpcRequests.WithLabelValues(method, status.Code(err).String()).Inc()
+	grpcDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// Учет длительности запроса к хранилищу. Вызывается через defer, поэтому ошибка передается по указателю.
+func observeQuery(method string, start time.Time, err *error) {
+	repoDuration.WithLabelValues(method, resultStatus(*err)).Observe(time.Since(start).Seconds())
+}
+
+// Учет изменения суммарного заполнения буферов saver.Saver на delta пользователей.
+func AddSaverBufferSize(delta int) {
+	saverBufferSize.Add(float64(delta))
+}
+
+// Учет пользователя, вытесненного из заполненного буфера saver.Saver.
+func IncSaverDropped() {
+	saverDropped.Inc()
+}
+
+func resultStatus(err error) string {
+	if err != nil {
+		return "error"
+	}
+
+	return "ok"
+}
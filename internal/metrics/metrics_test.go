@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/ocp.user.api.OcpUserApi/DescribeUserV1"}
+
+	handlers := []grpc.UnaryHandler{
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return req, nil
+		},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "user was not found")
+		},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "user was not found")
+		},
+	}
+
+	for _, handler := range handlers {
+		_, _ = interceptor(context.Background(), nil, info, handler)
+	}
+
+	if actual := testutil.ToFloat64(grpcRequests.WithLabelValues("DescribeUserV1", "OK")); actual != 1 {
+		t.Errorf("expected 1 successful request, but got %v", actual)
+	}
+
+	if actual := testutil.ToFloat64(grpcRequests.WithLabelValues("DescribeUserV1", "NotFound")); actual != 2 {
+		t.Errorf("expected 2 failed requests, but got %v", actual)
+	}
+
+	if actual := testutil.CollectAndCount(grpcDuration); actual != 1 {
+		t.Errorf("expected 1 latency series, but got %d", actual)
+	}
+}
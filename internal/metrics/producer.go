@@ -0,0 +1,38 @@
+package metrics
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+// Обертка над producer.Producer, учитывающая успешные и неуспешные отправки событий.
+func InstrumentProducer(eventProducer producer.Producer) producer.Producer {
+	return &instrumentedProducer{
+		producer: eventProducer,
+	}
+}
+
+type instrumentedProducer struct {
+	producer producer.Producer
+}
+
+func (p *instrumentedProducer) Init(ctx context.Context) error {
+	return p.producer.Init(ctx)
+}
+
+func (p *instrumentedProducer) SendEvent(event producer.Event) error {
+	err := p.producer.SendEvent(event)
+	eventsSent.WithLabelValues(strconv.FormatUint(uint64(event.Type), 10), resultStatus(err)).Inc()
+
+	return err
+}
+
+func (p *instrumentedProducer) Ping(ctx context.Context) error {
+	return p.producer.Ping(ctx)
+}
+
+func (p *instrumentedProducer) Close() {
+	p.producer.Close()
+}
@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/repo"
+)
+
+// Обертка над repo.Repo, учитывающая длительность запросов каждого метода.
+func InstrumentRepo(userRepo repo.Repo) repo.Repo {
+	return &instrumentedRepo{
+		repo: userRepo,
+	}
+}
+
+type instrumentedRepo struct {
+	repo repo.Repo
+}
+
+func (r *instrumentedRepo) CreateUser(ctx context.Context, user *models.User) (_ uint64, err error) {
+	defer observeQuery("CreateUser", time.Now(), &err)
+	return r.repo.CreateUser(ctx, user)
+}
+
+func (r *instrumentedRepo) CreateUsers(ctx context.Context, users []models.User) (_ []uint64, err error) {
+	defer observeQuery("CreateUsers", time.Now(), &err)
+	return r.repo.CreateUsers(ctx, users)
+}
+
+func (r *instrumentedRepo) CreateUsersAtomic(
+	ctx context.Context,
+	users []models.User,
+	chunkSize int,
+) (_ []uint64, err error) {
+	defer observeQuery("CreateUsersAtomic", time.Now(), &err)
+	return r.repo.CreateUsersAtomic(ctx, users, chunkSize)
+}
+
+func (r *instrumentedRepo) UpdateUser(
+	ctx context.Context,
+	user *models.User,
+	fields []models.UserField,
+) (_ bool, err error) {
+	defer observeQuery("UpdateUser", time.Now(), &err)
+	return r.repo.UpdateUser(ctx, user, fields)
+}
+
+func (r *instrumentedRepo) RemoveUser(ctx context.Context, userId uint64, expectedVersion uint64) (_ bool, err error) {
+	defer observeQuery("RemoveUser", time.Now(), &err)
+	return r.repo.RemoveUser(ctx, userId, expectedVersion)
+}
+
+func (r *instrumentedRepo) UpdateUsers(ctx context.Context, updates []models.UserUpdate, chunkSize int) (_ []repo.ChangeResult, err error) {
+	defer observeQuery("UpdateUsers", time.Now(), &err)
+	return r.repo.UpdateUsers(ctx, updates, chunkSize)
+}
+
+func (r *instrumentedRepo) RemoveUsers(ctx context.Context, userIds []uint64, chunkSize int) (_ []repo.ChangeResult, err error) {
+	defer observeQuery("RemoveUsers", time.Now(), &err)
+	return r.repo.RemoveUsers(ctx, userIds, chunkSize)
+}
+
+func (r *instrumentedRepo) RestoreUser(ctx context.Context, userId uint64) (_ bool, err error) {
+	defer observeQuery("RestoreUser", time.Now(), &err)
+	return r.repo.RestoreUser(ctx, userId)
+}
+
+func (r *instrumentedRepo) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (_ uint64, err error) {
+	defer observeQuery("PurgeDeletedUsers", time.Now(), &err)
+	return r.repo.PurgeDeletedUsers(ctx, deletedBefore)
+}
+
+func (r *instrumentedRepo) GetUser(ctx context.Context, userId uint64) (_ *models.User, err error) {
+	defer observeQuery("GetUser", time.Now(), &err)
+	return r.repo.GetUser(ctx, userId)
+}
+
+func (r *instrumentedRepo) GetUserAsOf(ctx context.Context, userId uint64, asOf time.Time) (_ *models.User, err error) {
+	defer observeQuery("GetUserAsOf", time.Now(), &err)
+	return r.repo.GetUserAsOf(ctx, userId, asOf)
+}
+
+func (r *instrumentedRepo) GetUsers(ctx context.Context, userIds []uint64) (_ []models.User, err error) {
+	defer observeQuery("GetUsers", time.Now(), &err)
+	return r.repo.GetUsers(ctx, userIds)
+}
+
+func (r *instrumentedRepo) SearchUsers(
+	ctx context.Context,
+	params models.UserSearchParams,
+) (_ *models.UserSearchResult, err error) {
+	defer observeQuery("SearchUsers", time.Now(), &err)
+	return r.repo.SearchUsers(ctx, params)
+}
+
+func (r *instrumentedRepo) ExportUsers(
+	ctx context.Context,
+	params models.UserSearchParams,
+	batchSize int,
+	fn func(users []models.User) error,
+) (err error) {
+	defer observeQuery("ExportUsers", time.Now(), &err)
+	return r.repo.ExportUsers(ctx, params, batchSize, fn)
+}
+
+func (r *instrumentedRepo) ListUserHistory(
+	ctx context.Context,
+	userId uint64,
+	afterId uint64,
+	count uint64,
+) (_ []models.UserHistoryEntry, err error) {
+	defer observeQuery("ListUserHistory", time.Now(), &err)
+	return r.repo.ListUserHistory(ctx, userId, afterId, count)
+}
+
+func (r *instrumentedRepo) Ping(ctx context.Context) (err error) {
+	defer observeQuery("Ping", time.Now(), &err)
+	return r.repo.Ping(ctx)
+}
@@ -0,0 +1,308 @@
+package migrations
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	schemaTable = "schema_migrations"
+
+	// Ключ блокировки, исключающей одновременное применение миграций несколькими экземплярами.
+	advisoryLockKey = 7002
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// Версионированная миграция схемы БД.
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Состояние миграции. AppliedAt равно nil для непримененных миграций.
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// Интерфейс предназначен для применения и отката встроенных в бинарный файл миграций.
+// Примененные версии хранятся в таблице schema_migrations.
+type Migrator interface {
+	// Применение всех непримененных миграций по возрастанию версий.
+	Up(ctx context.Context) ([]Migration, error)
+	// Откат steps последних примененных миграций.
+	Down(ctx context.Context, steps int) ([]Migration, error)
+	Status(ctx context.Context) ([]Status, error)
+}
+
+func NewMigrator(db *sqlx.DB) (Migrator, error) {
+	migrations, err := load(files, "sql")
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+type migrator struct {
+	db         *sqlx.DB
+	migrations []Migration
+}
+
+func (m *migrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := m.ensureSchemaTable(ctx); err != nil {
+		return nil, err
+	}
+
+	applied := make([]Migration, 0)
+
+	for _, migration := range m.migrations {
+		migration := migration
+
+		done, err := m.inLockedTransaction(ctx, func(tx *sqlx.Tx, versions map[uint64]time.Time) (bool, error) {
+			if _, exists := versions[migration.Version]; exists {
+				return false, nil
+			}
+
+			if _, err := tx.ExecContext(ctx, migration.Up); err != nil {
+				return false, err
+			}
+
+			_, err := tx.ExecContext(ctx,
+				"INSERT INTO "+schemaTable+" (version, name) VALUES ($1, $2)",
+				migration.Version, migration.Name,
+			)
+			return err == nil, err
+		})
+
+		if err != nil {
+			return applied, fmt.Errorf("apply migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		if done {
+			applied = append(applied, migration)
+		}
+	}
+
+	return applied, nil
+}
+
+func (m *migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	if err := m.ensureSchemaTable(ctx); err != nil {
+		return nil, err
+	}
+
+	reverted := make([]Migration, 0, steps)
+
+	for i := 0; i < steps; i++ {
+		var migration *Migration
+
+		done, err := m.inLockedTransaction(ctx, func(tx *sqlx.Tx, versions map[uint64]time.Time) (bool, error) {
+			migration = m.lastApplied(versions)
+			if migration == nil {
+				return false, nil
+			}
+
+			if _, err := tx.ExecContext(ctx, migration.Down); err != nil {
+				return false, err
+			}
+
+			_, err := tx.ExecContext(ctx, "DELETE FROM "+schemaTable+" WHERE version = $1", migration.Version)
+			return err == nil, err
+		})
+
+		if err != nil && migration != nil {
+			return reverted, fmt.Errorf("revert migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+
+		if err != nil {
+			return reverted, err
+		}
+
+		if !done {
+			break
+		}
+
+		reverted = append(reverted, *migration)
+	}
+
+	return reverted, nil
+}
+
+func (m *migrator) Status(ctx context.Context) ([]Status, error) {
+	if err := m.ensureSchemaTable(ctx); err != nil {
+		return nil, err
+	}
+
+	versions, err := appliedVersions(ctx, m.db)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Status, 0, len(m.migrations))
+
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+
+		if appliedAt, exists := versions[migration.Version]; exists {
+			appliedAt := appliedAt
+			status.AppliedAt = &appliedAt
+		}
+
+		result = append(result, status)
+	}
+
+	return result, nil
+}
+
+func (m *migrator) lastApplied(versions map[uint64]time.Time) *Migration {
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		if _, exists := versions[m.migrations[i].Version]; exists {
+			return &m.migrations[i]
+		}
+	}
+
+	return nil
+}
+
+func (m *migrator) ensureSchemaTable(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+schemaTable+` (
+		version    BIGINT PRIMARY KEY,
+		name       TEXT NOT NULL,
+		applied_at TIMESTAMPTZ NOT NULL DEFAULT now()
+	)`)
+
+	return err
+}
+
+// Выполнение fn в транзакции под блокировкой миграций. Функции передаются версии,
+// примененные на момент получения блокировки. Транзакция фиксируется, если fn вернула true.
+func (m *migrator) inLockedTransaction(
+	ctx context.Context,
+	fn func(tx *sqlx.Tx, versions map[uint64]time.Time) (bool, error),
+) (bool, error) {
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", advisoryLockKey); err != nil {
+		return false, err
+	}
+
+	versions, err := appliedVersions(ctx, tx)
+	if err != nil {
+		return false, err
+	}
+
+	done, err := fn(tx, versions)
+	if err != nil || !done {
+		return false, err
+	}
+
+	return true, tx.Commit()
+}
+
+func appliedVersions(ctx context.Context, db sqlx.QueryerContext) (map[uint64]time.Time, error) {
+	rows, err := db.QueryContext(ctx, "SELECT version, applied_at FROM "+schemaTable)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	versions := make(map[uint64]time.Time)
+
+	for rows.Next() {
+		var version uint64
+		var appliedAt time.Time
+
+		if err := rows.Scan(&version, &appliedAt); err != nil {
+			return nil, err
+		}
+
+		versions[version] = appliedAt
+	}
+
+	return versions, rows.Err()
+}
+
+// Загрузка миграций из каталога dir. Каждая версия должна иметь файлы
+// <версия>_<имя>.up.sql и <версия>_<имя>.down.sql.
+func load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint64]*Migration)
+
+	for _, entry := range entries {
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected migration file name %s", entry.Name())
+		}
+
+		version, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, exists := byVersion[version]
+		if !exists {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		} else if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names %s and %s", version, migration.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
@@ -0,0 +1,164 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+func TestLoad(t *testing.T) {
+	cases := []struct {
+		name     string
+		files    fstest.MapFS
+		expected []Migration
+		isError  bool
+	}{
+		{
+			name: "SortedByVersion",
+			files: fstest.MapFS{
+				"sql/0002_add_email.up.sql":      {Data: []byte("up 2")},
+				"sql/0002_add_email.down.sql":    {Data: []byte("down 2")},
+				"sql/0001_create_users.up.sql":   {Data: []byte("up 1")},
+				"sql/0001_create_users.down.sql": {Data: []byte("down 1")},
+			},
+			expected: []Migration{
+				{Version: 1, Name: "create_users", Up: "up 1", Down: "down 1"},
+				{Version: 2, Name: "add_email", Up: "up 2", Down: "down 2"},
+			},
+		},
+		{
+			name: "MissingDown",
+			files: fstest.MapFS{
+				"sql/0001_create_users.up.sql": {Data: []byte("up 1")},
+			},
+			isError: true,
+		},
+		{
+			name: "DifferentNames",
+			files: fstest.MapFS{
+				"sql/0001_create_users.up.sql":   {Data: []byte("up 1")},
+				"sql/0001_create_tasks.down.sql": {Data: []byte("down 1")},
+			},
+			isError: true,
+		},
+		{
+			name: "UnexpectedFile",
+			files: fstest.MapFS{
+				"sql/create_users.sql": {Data: []byte("up 1")},
+			},
+			isError: true,
+		},
+	}
+
+	for _, item := range cases {
+		actual, err := load(item.files, "sql")
+
+		if item.isError {
+			if err == nil {
+				t.Errorf("%s: expected error, but got %v", item.name, actual)
+			}
+		} else if !reflect.DeepEqual(actual, item.expected) {
+			t.Errorf("%s: expected %v, but got %v (error %v)", item.name, item.expected, actual, err)
+		}
+	}
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := load(files, "sql")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	for i, migration := range migrations {
+		if migration.Version != uint64(i+1) {
+			t.Errorf("expected version %d, but got %d", i+1, migration.Version)
+		}
+	}
+}
+
+func TestEmailUniqueMigrationChecksDuplicates(t *testing.T) {
+	migrations, err := load(files, "sql")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	up := migrations[4].Up
+
+	check := strings.Index(up, "RAISE EXCEPTION 'duplicate emails")
+	update := strings.Index(up, "UPDATE users SET email")
+	index := strings.Index(up, "CREATE UNIQUE INDEX users_email_unique_idx")
+
+	if check < 0 || check > update || check > index {
+		t.Errorf("expected duplicate emails check before normalization and index creation, but got %q", up)
+	}
+}
+
+func TestUpStopsOnDuplicateEmails(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	migrations, err := load(files, "sql")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	m := &migrator{db: sqlx.NewDb(db, "postgres"), migrations: migrations}
+
+	// Миграции 1-4 уже применены.
+	applied := func() *sqlmock.Rows {
+		rows := sqlmock.NewRows([]string{"version", "applied_at"})
+		for version := 1; version <= 4; version++ {
+			rows.AddRow(version, time.Now())
+		}
+
+		return rows
+	}
+
+	duplicates := &pq.Error{
+		Code:    "23505",
+		Message: "duplicate emails among users: a@example.com (users 1, 2)",
+	}
+
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	for _, migration := range migrations[:5] {
+		mock.ExpectBegin()
+		mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).
+			WithArgs(advisoryLockKey).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery("SELECT version, applied_at FROM schema_migrations").WillReturnRows(applied())
+
+		if migration.Version == 5 {
+			mock.ExpectExec(regexp.QuoteMeta(migration.Up)).WillReturnError(duplicates)
+		}
+
+		mock.ExpectRollback()
+	}
+
+	actual, err := m.Up(context.Background())
+
+	if len(actual) != 0 {
+		t.Errorf("expected no applied migrations, but got %v", actual)
+	}
+
+	if !errors.Is(err, duplicates) || !strings.Contains(err.Error(), "apply migration 5_add_users_email_unique") {
+		t.Errorf("expected duplicate emails error of migration 5, but got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,21 @@
+CREATE TABLE users (
+    id          BIGSERIAL PRIMARY KEY,
+    calendar_id BIGINT NOT NULL DEFAULT 0 CHECK (calendar_id >= 0),
+    resume_id   BIGINT NOT NULL DEFAULT 0 CHECK (resume_id >= 0),
+    name        TEXT NOT NULL DEFAULT '' CHECK (length(name) <= 255),
+    surname     TEXT NOT NULL DEFAULT '' CHECK (length(surname) <= 255),
+    patronymic  TEXT NOT NULL DEFAULT '' CHECK (length(patronymic) <= 255),
+    email       TEXT NOT NULL DEFAULT '' CHECK (length(email) <= 320),
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Индексы для сортировки с пагинацией по ключу (поле, id) и поиска по префиксу без учета регистра.
+CREATE INDEX users_name_idx ON users (name, id);
+CREATE INDEX users_surname_idx ON users (surname, id);
+CREATE INDEX users_patronymic_idx ON users (patronymic, id);
+CREATE INDEX users_email_idx ON users (email, id);
+CREATE INDEX users_calendar_id_idx ON users (calendar_id, id);
+CREATE INDEX users_resume_id_idx ON users (resume_id, id);
+CREATE INDEX users_lower_name_prefix_idx ON users (lower(name) text_pattern_ops);
+CREATE INDEX users_lower_surname_prefix_idx ON users (lower(surname) text_pattern_ops);
+CREATE INDEX users_lower_patronymic_prefix_idx ON users (lower(patronymic) text_pattern_ops);
@@ -0,0 +1,12 @@
+CREATE TABLE user_events_outbox (
+    id              BIGSERIAL PRIMARY KEY,
+    event_type      INTEGER NOT NULL,
+    payload         JSONB NOT NULL,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT now(),
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    attempts        INTEGER NOT NULL DEFAULT 0,
+    sent_at         TIMESTAMPTZ,
+    last_error      TEXT
+);
+
+CREATE INDEX user_events_outbox_pending_idx ON user_events_outbox (next_attempt_at, id) WHERE sent_at IS NULL;
@@ -0,0 +1,2 @@
+-- Версия пользователя для оптимистичной блокировки: увеличивается при каждом изменении.
+ALTER TABLE users ADD COLUMN version BIGINT NOT NULL DEFAULT 1 CHECK (version > 0);
@@ -0,0 +1,4 @@
+-- Мягкое удаление: удаленные пользователи помечаются временем удаления и скрываются из выборок.
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX users_deleted_at_idx ON users (deleted_at) WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,32 @@
+-- Адреса электронной почты хранятся в нормализованном виде и уникальны без учета регистра
+-- среди неудаленных пользователей. Пустой адрес уникальности не требует.
+--
+-- Если неудаленные пользователи уже используют одинаковые адреса, миграция прерывается со списком
+-- адресов и идентификаторов пользователей, не изменяя данные. Дубликаты устраняются вручную:
+-- лишних пользователей удаляют (UPDATE users SET deleted_at = now() WHERE id IN (...))
+-- или меняют им адрес, после чего миграцию применяют повторно.
+DO $$
+DECLARE
+    duplicates TEXT;
+BEGIN
+    SELECT string_agg(format('%s (users %s)', email, ids), ', ' ORDER BY email)
+    INTO duplicates
+    FROM (
+        SELECT lower(btrim(email)) AS email, array_to_string(array_agg(id ORDER BY id), ', ') AS ids
+        FROM users
+        WHERE deleted_at IS NULL AND btrim(email) <> ''
+        GROUP BY lower(btrim(email))
+        HAVING count(*) > 1
+    ) AS duplicate_emails;
+
+    IF duplicates IS NOT NULL THEN
+        RAISE EXCEPTION 'duplicate emails among users: %', duplicates
+            USING ERRCODE = 'unique_violation',
+                  HINT = 'remove extra users or change their emails, then apply the migration again';
+    END IF;
+END
+$$;
+
+UPDATE users SET email = lower(btrim(email)) WHERE email <> lower(btrim(email));
+
+CREATE UNIQUE INDEX users_email_unique_idx ON users (lower(email)) WHERE deleted_at IS NULL AND email <> '';
@@ -0,0 +1,13 @@
+-- Ключи идемпотентности запросов создания: повторный запрос с тем же ключом получает сохраненный ответ.
+CREATE TABLE idempotency_keys (
+    method       TEXT NOT NULL,
+    key          TEXT NOT NULL,
+    request_hash BYTEA NOT NULL,
+    response     BYTEA,
+    user_ids     BIGINT[],
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (method, key)
+);
+
+CREATE INDEX idempotency_keys_expires_at_idx ON idempotency_keys (expires_at);
@@ -0,0 +1,14 @@
+-- История изменений пользователей: инициатор, трасса и значения измененных полей до и после изменения.
+-- Записи не ссылаются на users, чтобы история сохранялась после окончательного удаления пользователя.
+CREATE TABLE user_history (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT NOT NULL,
+    operation  TEXT NOT NULL CHECK (operation IN ('create', 'update', 'remove', 'restore')),
+    actor      TEXT NOT NULL DEFAULT '',
+    trace_id   TEXT NOT NULL DEFAULT '',
+    version    BIGINT NOT NULL,
+    changes    JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX user_history_user_id_idx ON user_history (user_id, id);
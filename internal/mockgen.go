@@ -2,4 +2,8 @@ package internal
 
 //go:generate mockgen -destination=./mocks/repo_mock.go -package=mocks github.com/ozoncp/ocp-user-api/internal/repo Repo
 //go:generate mockgen -destination=./mocks/flusher_mock.go -package=mocks github.com/ozoncp/ocp-user-api/internal/flusher Flusher
+//go:generate mockgen -destination=./mocks/saver_mock.go -package=mocks github.com/ozoncp/ocp-user-api/internal/saver Saver,Buffer
 //go:generate mockgen -destination=./mocks/alarm_mock.go -package=mocks github.com/ozoncp/ocp-user-api/internal/alarm Alarm
+//go:generate mockgen -destination=./mocks/producer_mock.go -package=mocks github.com/ozoncp/ocp-user-api/internal/producer Producer
+//go:generate mockgen -destination=./mocks/outbox_mock.go -package=mocks github.com/ozoncp/ocp-user-api/internal/outbox Store
+//go:generate mockgen -destination=./mocks/idempotency_mock.go -package=mocks -mock_names=Store=MockIdempotencyStore github.com/ozoncp/ocp-user-api/internal/idempotency Store
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	flusher "github.com/ozoncp/ocp-user-api/internal/flusher"
 	models "github.com/ozoncp/ocp-user-api/internal/models"
 )
 
@@ -36,10 +37,10 @@ func (m *MockFlusher) EXPECT() *MockFlusherMockRecorder {
 }
 
 // Flush mocks base method.
-func (m *MockFlusher) Flush(arg0 context.Context, arg1 []models.User) int {
+func (m *MockFlusher) Flush(arg0 context.Context, arg1 []models.User) []flusher.Failure {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Flush", arg0, arg1)
-	ret0, _ := ret[0].(int)
+	ret0, _ := ret[0].([]flusher.Failure)
 	return ret0
 }
 
@@ -0,0 +1,94 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozoncp/ocp-user-api/internal/idempotency (interfaces: Store)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	idempotency "github.com/ozoncp/ocp-user-api/internal/idempotency"
+)
+
+// MockIdempotencyStore is a mock of Store interface.
+type MockIdempotencyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyStoreMockRecorder
+}
+
+// MockIdempotencyStoreMockRecorder is the mock recorder for MockIdempotencyStore.
+type MockIdempotencyStoreMockRecorder struct {
+	mock *MockIdempotencyStore
+}
+
+// NewMockIdempotencyStore creates a new mock instance.
+func NewMockIdempotencyStore(ctrl *gomock.Controller) *MockIdempotencyStore {
+	mock := &MockIdempotencyStore{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyStore) EXPECT() *MockIdempotencyStoreMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyStore) Complete(arg0 context.Context, arg1, arg2 string, arg3 idempotency.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyStoreMockRecorder) Complete(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyStore)(nil).Complete), arg0, arg1, arg2, arg3)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIdempotencyStore) DeleteExpired(arg0 context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", arg0)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIdempotencyStoreMockRecorder) DeleteExpired(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIdempotencyStore)(nil).DeleteExpired), arg0)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyStore) Release(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyStoreMockRecorder) Release(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyStore)(nil).Release), arg0, arg1, arg2)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotencyStore) Reserve(arg0 context.Context, arg1, arg2 string, arg3 []byte) (*idempotency.Record, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*idempotency.Record)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyStoreMockRecorder) Reserve(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotencyStore)(nil).Reserve), arg0, arg1, arg2, arg3)
+}
@@ -7,9 +7,11 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	models "github.com/ozoncp/ocp-user-api/internal/models"
+	repo "github.com/ozoncp/ocp-user-api/internal/repo"
 )
 
 // MockRepo is a mock of Repo interface.
@@ -65,6 +67,35 @@ func (mr *MockRepoMockRecorder) CreateUsers(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsers", reflect.TypeOf((*MockRepo)(nil).CreateUsers), arg0, arg1)
 }
 
+// CreateUsersAtomic mocks base method.
+func (m *MockRepo) CreateUsersAtomic(arg0 context.Context, arg1 []models.User, arg2 int) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUsersAtomic", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUsersAtomic indicates an expected call of CreateUsersAtomic.
+func (mr *MockRepoMockRecorder) CreateUsersAtomic(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsersAtomic", reflect.TypeOf((*MockRepo)(nil).CreateUsersAtomic), arg0, arg1, arg2)
+}
+
+// ExportUsers mocks base method.
+func (m *MockRepo) ExportUsers(arg0 context.Context, arg1 models.UserSearchParams, arg2 int, arg3 func([]models.User) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportUsers", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportUsers indicates an expected call of ExportUsers.
+func (mr *MockRepoMockRecorder) ExportUsers(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportUsers", reflect.TypeOf((*MockRepo)(nil).ExportUsers), arg0, arg1, arg2, arg3)
+}
+
 // GetUser mocks base method.
 func (m *MockRepo) GetUser(arg0 context.Context, arg1 uint64) (*models.User, error) {
 	m.ctrl.T.Helper()
@@ -80,6 +111,21 @@ func (mr *MockRepoMockRecorder) GetUser(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockRepo)(nil).GetUser), arg0, arg1)
 }
 
+// GetUserAsOf mocks base method.
+func (m *MockRepo) GetUserAsOf(arg0 context.Context, arg1 uint64, arg2 time.Time) (*models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAsOf", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAsOf indicates an expected call of GetUserAsOf.
+func (mr *MockRepoMockRecorder) GetUserAsOf(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAsOf", reflect.TypeOf((*MockRepo)(nil).GetUserAsOf), arg0, arg1, arg2)
+}
+
 // GetUsers mocks base method.
 func (m *MockRepo) GetUsers(arg0 context.Context, arg1 []uint64) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -95,19 +141,93 @@ func (mr *MockRepoMockRecorder) GetUsers(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockRepo)(nil).GetUsers), arg0, arg1)
 }
 
+// ListUserHistory mocks base method.
+func (m *MockRepo) ListUserHistory(arg0 context.Context, arg1, arg2, arg3 uint64) ([]models.UserHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.UserHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserHistory indicates an expected call of ListUserHistory.
+func (mr *MockRepoMockRecorder) ListUserHistory(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserHistory", reflect.TypeOf((*MockRepo)(nil).ListUserHistory), arg0, arg1, arg2, arg3)
+}
+
+// Ping mocks base method.
+func (m *MockRepo) Ping(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockRepoMockRecorder) Ping(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockRepo)(nil).Ping), arg0)
+}
+
+// PurgeDeletedUsers mocks base method.
+func (m *MockRepo) PurgeDeletedUsers(arg0 context.Context, arg1 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeDeletedUsers", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeDeletedUsers indicates an expected call of PurgeDeletedUsers.
+func (mr *MockRepoMockRecorder) PurgeDeletedUsers(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeDeletedUsers", reflect.TypeOf((*MockRepo)(nil).PurgeDeletedUsers), arg0, arg1)
+}
+
 // RemoveUser mocks base method.
-func (m *MockRepo) RemoveUser(arg0 context.Context, arg1 uint64) (bool, error) {
+func (m *MockRepo) RemoveUser(arg0 context.Context, arg1, arg2 uint64) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveUser", arg0, arg1)
+	ret := m.ctrl.Call(m, "RemoveUser", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RemoveUser indicates an expected call of RemoveUser.
-func (mr *MockRepoMockRecorder) RemoveUser(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRepoMockRecorder) RemoveUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockRepo)(nil).RemoveUser), arg0, arg1, arg2)
+}
+
+// RemoveUsers mocks base method.
+func (m *MockRepo) RemoveUsers(arg0 context.Context, arg1 []uint64, arg2 int) ([]repo.ChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUsers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]repo.ChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveUsers indicates an expected call of RemoveUsers.
+func (mr *MockRepoMockRecorder) RemoveUsers(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsers", reflect.TypeOf((*MockRepo)(nil).RemoveUsers), arg0, arg1, arg2)
+}
+
+// RestoreUser mocks base method.
+func (m *MockRepo) RestoreUser(arg0 context.Context, arg1 uint64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreUser", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreUser indicates an expected call of RestoreUser.
+func (mr *MockRepoMockRecorder) RestoreUser(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockRepo)(nil).RemoveUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreUser", reflect.TypeOf((*MockRepo)(nil).RestoreUser), arg0, arg1)
 }
 
 // SearchUsers mocks base method.
@@ -126,16 +246,31 @@ func (mr *MockRepoMockRecorder) SearchUsers(arg0, arg1 interface{}) *gomock.Call
 }
 
 // UpdateUser mocks base method.
-func (m *MockRepo) UpdateUser(arg0 context.Context, arg1 *models.User) (bool, error) {
+func (m *MockRepo) UpdateUser(arg0 context.Context, arg1 *models.User, arg2 []models.UserField) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1)
+	ret := m.ctrl.Call(m, "UpdateUser", arg0, arg1, arg2)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockRepoMockRecorder) UpdateUser(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockRepoMockRecorder) UpdateUser(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockRepo)(nil).UpdateUser), arg0, arg1, arg2)
+}
+
+// UpdateUsers mocks base method.
+func (m *MockRepo) UpdateUsers(arg0 context.Context, arg1 []models.UserUpdate, arg2 int) ([]repo.ChangeResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUsers", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]repo.ChangeResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUsers indicates an expected call of UpdateUsers.
+func (mr *MockRepoMockRecorder) UpdateUsers(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockRepo)(nil).UpdateUser), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUsers", reflect.TypeOf((*MockRepo)(nil).UpdateUsers), arg0, arg1, arg2)
 }
@@ -0,0 +1,134 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/ozoncp/ocp-user-api/internal/saver (interfaces: Saver,Buffer)
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	models "github.com/ozoncp/ocp-user-api/internal/models"
+	saver "github.com/ozoncp/ocp-user-api/internal/saver"
+)
+
+// MockSaver is a mock of Saver interface.
+type MockSaver struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaverMockRecorder
+}
+
+// MockSaverMockRecorder is the mock recorder for MockSaver.
+type MockSaverMockRecorder struct {
+	mock *MockSaver
+}
+
+// NewMockSaver creates a new mock instance.
+func NewMockSaver(ctrl *gomock.Controller) *MockSaver {
+	mock := &MockSaver{ctrl: ctrl}
+	mock.recorder = &MockSaverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaver) EXPECT() *MockSaverMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSaver) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSaverMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSaver)(nil).Close))
+}
+
+// Init mocks base method.
+func (m *MockSaver) Init(arg0 context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Init", arg0)
+}
+
+// Init indicates an expected call of Init.
+func (mr *MockSaverMockRecorder) Init(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Init", reflect.TypeOf((*MockSaver)(nil).Init), arg0)
+}
+
+// Open mocks base method.
+func (m *MockSaver) Open(arg0 context.Context) saver.Buffer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Open", arg0)
+	ret0, _ := ret[0].(saver.Buffer)
+	return ret0
+}
+
+// Open indicates an expected call of Open.
+func (mr *MockSaverMockRecorder) Open(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockSaver)(nil).Open), arg0)
+}
+
+// MockBuffer is a mock of Buffer interface.
+type MockBuffer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBufferMockRecorder
+}
+
+// MockBufferMockRecorder is the mock recorder for MockBuffer.
+type MockBufferMockRecorder struct {
+	mock *MockBuffer
+}
+
+// NewMockBuffer creates a new mock instance.
+func NewMockBuffer(ctrl *gomock.Controller) *MockBuffer {
+	mock := &MockBuffer{ctrl: ctrl}
+	mock.recorder = &MockBufferMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuffer) EXPECT() *MockBufferMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockBuffer) Close() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Close")
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockBufferMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBuffer)(nil).Close))
+}
+
+// Flush mocks base method.
+func (m *MockBuffer) Flush() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Flush")
+}
+
+// Flush indicates an expected call of Flush.
+func (mr *MockBufferMockRecorder) Flush() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Flush", reflect.TypeOf((*MockBuffer)(nil).Flush))
+}
+
+// Save mocks base method.
+func (m *MockBuffer) Save(arg0 models.User, arg1 saver.DoneFunc) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Save", arg0, arg1)
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockBufferMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockBuffer)(nil).Save), arg0, arg1)
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"time"
+)
+
+// Вид изменения пользователя в истории.
+type UserOperation string
+
+const (
+	UserCreated  UserOperation = "create"
+	UserUpdated  UserOperation = "update"
+	UserRemoved  UserOperation = "remove"
+	UserRestored UserOperation = "restore"
+)
+
+// Значения поля до и после изменения в текстовом виде. Nil означает отсутствие значения:
+// поле Before при создании пользователя, время удаления у неудаленного пользователя.
+type FieldChange struct {
+	Before *string `json:"before"`
+	After  *string `json:"after"`
+}
+
+// Запись истории изменений пользователя. Changes содержит только измененные поля
+// и индексируется именами столбцов хранилища; Version — версия пользователя после изменения.
+type UserHistoryEntry struct {
+	Id        uint64
+	UserId    uint64
+	Operation UserOperation
+	Actor     string
+	TraceId   string
+	Version   uint64
+	Changes   map[string]FieldChange
+	CreatedAt time.Time
+}
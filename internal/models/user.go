@@ -1,26 +1,98 @@
 package models
 
+import "time"
+
 // Это очень приближенная структура типа User. Она будет расширяться и изменяться по мере прохождения уроков
 // и выяснения требований к интерфейсам типа. Также, я бы приземлял структуру типа на особенности выбранной
 // СУБД для проекта и требований к API.
 // Если подытожить, кажется преждевременно прорабатывать вид типа User, с точки зрения как надо.
 
 type User struct {
-	Id         uint64
-	CalendarId uint64
-	ResumeId   uint64
+	Id         uint64     `db:"id"`
+	CalendarId uint64     `db:"calendar_id"`
+	ResumeId   uint64     `db:"resume_id"`
+	Name       string     `db:"name"`
+	Surname    string     `db:"surname"`
+	Patronymic string     `db:"patronymic"`
+	Email      string     `db:"email"`
+	Version    uint64     `db:"version"`
+	DeletedAt  *time.Time `db:"deleted_at"`
+}
+
+// Изменяемое поле пользователя.
+type UserField int
+
+const (
+	UserFieldCalendarId UserField = iota
+	UserFieldResumeId
+	UserFieldName
+	UserFieldSurname
+	UserFieldPatronymic
+	UserFieldEmail
+)
+
+// Все изменяемые поля пользователя.
+var AllUserFields = []UserField{
+	UserFieldCalendarId,
+	UserFieldResumeId,
+	UserFieldName,
+	UserFieldSurname,
+	UserFieldPatronymic,
+	UserFieldEmail,
+}
+
+// Изменение пользователя в групповом обновлении: поля Fields пользователя User.Id получают значения из User.
+// Если User.Version не равно нулю, изменяется только эта версия пользователя.
+type UserUpdate struct {
+	User   User
+	Fields []UserField
+}
+
+// Поле, по которому упорядочивается результат поиска пользователей.
+// Для всех полей, кроме идентификатора, дополнительно выполняется сортировка по идентификатору.
+type UserSortField int
+
+const (
+	SortById UserSortField = iota
+	SortByName
+	SortBySurname
+	SortByPatronymic
+	SortByEmail
+	SortByCalendarId
+	SortByResumeId
+)
+
+// Фильтр поиска пользователей. Пустые значения полей не участвуют в фильтрации.
+// Поля Name, Surname и Patronymic сравниваются по префиксу без учета регистра.
+type UserSearchFilter struct {
 	Name       string
 	Surname    string
 	Patronymic string
 	Email      string
+	CalendarId uint64
+	ResumeId   uint64
+	// Включать в выборку удаленных пользователей.
+	IncludeDeleted bool
+}
+
+// Позиция пользователя в упорядоченной выборке: идентификатор и строковое представление значения поля сортировки.
+type UserCursor struct {
+	Id    uint64
+	Value string
 }
 
+// Параметры поиска пользователей. Если задано поле After, выборка начинается после указанной позиции.
 type UserSearchParams struct {
-	Count  uint64
-	Offset uint64
+	Count     uint64
+	After     *UserCursor
+	Filter    UserSearchFilter
+	SortField UserSortField
+	SortDesc  bool
 }
 
+// Результат поиска пользователей. Поле Next содержит позицию последнего пользователя страницы
+// и равно nil, если следующей страницы нет.
 type UserSearchResult struct {
-	Items      []User
-	NextOffset uint64
+	Items []User
+	Next  *UserCursor
 }
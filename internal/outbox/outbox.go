@@ -0,0 +1,145 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+const (
+	tableName = "user_events_outbox"
+)
+
+// Сообщение исходящей очереди событий.
+type Message struct {
+	Id       uint64
+	Event    producer.Event
+	Attempts int
+}
+
+// Запись событий в исходящую очередь. Вызывается в той же транзакции, что и изменение данных,
+// поэтому событие будет опубликовано тогда и только тогда, когда изменение зафиксировано.
+func Put(ctx context.Context, tx sqlx.ExecerContext, events ...producer.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	query := squirrel.Insert(tableName).
+		Columns("event_type", "payload").
+		PlaceholderFormat(squirrel.Dollar)
+
+	for _, event := range events {
+		payload, err := json.Marshal(event.Payload)
+		if err != nil {
+			return err
+		}
+
+		query = query.Values(event.Type, payload)
+	}
+
+	sql, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, sql, args...)
+	return err
+}
+
+// Интерфейс хранилища исходящей очереди событий.
+type Store interface {
+	// Захватывает до limit готовых к отправке сообщений на время lease.
+	// Пока захват не истек, сообщения не будут выданы повторно, в том числе другим экземплярам сервиса.
+	Acquire(ctx context.Context, limit int, lease time.Duration) ([]Message, error)
+	MarkSent(ctx context.Context, ids []uint64) error
+	MarkFailed(ctx context.Context, id uint64, retryAt time.Time, reason string) error
+}
+
+func NewStore(db *sqlx.DB) Store {
+	return &store{db: db}
+}
+
+type store struct {
+	db *sqlx.DB
+}
+
+func (s *store) Acquire(ctx context.Context, limit int, lease time.Duration) ([]Message, error) {
+	pending := squirrel.Select("id").
+		From(tableName).
+		Where("sent_at IS NULL AND next_attempt_at <= now()").
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	query := squirrel.Update(tableName).
+		Set("next_attempt_at", squirrel.Expr("now() + make_interval(secs => ?)", lease.Seconds())).
+		Where(squirrel.Expr("id IN (?)", pending)).
+		Suffix("RETURNING id, event_type, payload, attempts").
+		RunWith(s.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	rows, err := query.QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	messages := make([]Message, 0, limit)
+
+	for rows.Next() {
+		var message Message
+		var payload []byte
+
+		if err := rows.Scan(&message.Id, &message.Event.Type, &payload, &message.Attempts); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(payload, &message.Event.Payload); err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+
+	return messages, rows.Err()
+}
+
+func (s *store) MarkSent(ctx context.Context, ids []uint64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	messageIds := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		messageIds = append(messageIds, int64(id))
+	}
+
+	query := squirrel.Update(tableName).
+		Set("sent_at", squirrel.Expr("now()")).
+		Where("id = ANY(?)", messageIds).
+		RunWith(s.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	_, err := query.ExecContext(ctx)
+	return err
+}
+
+func (s *store) MarkFailed(ctx context.Context, id uint64, retryAt time.Time, reason string) error {
+	query := squirrel.Update(tableName).
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Set("next_attempt_at", retryAt).
+		Set("last_error", reason).
+		Where(squirrel.Eq{"id": id}).
+		RunWith(s.db).
+		PlaceholderFormat(squirrel.Dollar)
+
+	_, err := query.ExecContext(ctx)
+	return err
+}
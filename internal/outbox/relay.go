@@ -0,0 +1,144 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/ozoncp/ocp-user-api/internal/alarm"
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+// Интерфейс фоновой публикации событий из исходящей очереди в брокер сообщений.
+type Relay interface {
+	Init(ctx context.Context)
+	Close()
+}
+
+// Параметры повторных попыток публикации.
+// Задержка перед очередной попыткой удваивается, начиная с MinBackoff, и не превышает MaxBackoff.
+type RetryPolicy struct {
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	backoff := p.MinBackoff
+
+	for i := 0; i < attempts && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+
+	return backoff
+}
+
+func NewRelay(
+	batchSize int,
+	retryPolicy RetryPolicy,
+	alarm alarm.Alarm,
+	store Store,
+	eventProducer producer.Producer,
+) Relay {
+	return &relay{
+		batchSize:     batchSize,
+		retryPolicy:   retryPolicy,
+		done:          make(chan struct{}),
+		close:         make(chan struct{}),
+		alarm:         alarm,
+		store:         store,
+		eventProducer: eventProducer,
+	}
+}
+
+// Реализация интерфейса Relay. По сигналу alarm публикует готовые к отправке события пачками
+// размером batchSize, пока очередь не опустеет. Неотправленные события откладываются согласно retryPolicy.
+type relay struct {
+	batchSize     int
+	retryPolicy   RetryPolicy
+	done          chan struct{}
+	close         chan struct{}
+	alarm         alarm.Alarm
+	store         Store
+	eventProducer producer.Producer
+}
+
+func (r *relay) Init(ctx context.Context) {
+	go r.run(ctx)
+}
+
+func (r *relay) lease() time.Duration {
+	return r.retryPolicy.MaxBackoff
+}
+
+// Публикация одной пачки событий. Возвращает количество захваченных сообщений.
+func (r *relay) publish(ctx context.Context) int {
+	messages, err := r.store.Acquire(ctx, r.batchSize, r.lease())
+	if err != nil {
+		log.Error().Err(err).Msg("acquire outbox messages")
+		return 0
+	}
+
+	sent := make([]uint64, 0, len(messages))
+
+	for _, message := range messages {
+		if err := r.eventProducer.SendEvent(message.Event); err != nil {
+			log.Error().Err(err).Uint64("messageId", message.Id).Int("attempts", message.Attempts).Msg("publish outbox message")
+
+			retryAt := time.Now().Add(r.retryPolicy.Backoff(message.Attempts))
+			if err := r.store.MarkFailed(ctx, message.Id, retryAt, err.Error()); err != nil {
+				log.Error().Err(err).Uint64("messageId", message.Id).Msg("mark outbox message as failed")
+			}
+
+			continue
+		}
+
+		sent = append(sent, message.Id)
+	}
+
+	if err := r.store.MarkSent(ctx, sent); err != nil {
+		log.Error().Err(err).Msg("mark outbox messages as sent")
+	}
+
+	return len(messages)
+}
+
+func (r *relay) flush(ctx context.Context) {
+	for {
+		if r.publish(ctx) < r.batchSize {
+			return
+		}
+	}
+}
+
+func (r *relay) run(ctx context.Context) {
+	defer close(r.done)
+
+	signal := r.alarm.Alarm()
+
+	for {
+		select {
+		case _, ok := <-signal:
+			if !ok {
+				return
+			}
+
+			r.flush(ctx)
+
+		case <-r.close:
+			return
+
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *relay) Close() {
+	close(r.close)
+	<-r.done
+}
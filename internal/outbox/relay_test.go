@@ -0,0 +1,119 @@
+package outbox_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ozoncp/ocp-user-api/internal/mocks"
+	"github.com/ozoncp/ocp-user-api/internal/outbox"
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+var _ = Describe("Relay", func() {
+
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockAlarm    *mocks.MockAlarm
+		mockStore    *mocks.MockStore
+		mockProducer *mocks.MockProducer
+
+		alarms      chan struct{}
+		retryPolicy outbox.RetryPolicy
+		relay       outbox.Relay
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockAlarm = mocks.NewMockAlarm(ctrl)
+		mockStore = mocks.NewMockStore(ctrl)
+		mockProducer = mocks.NewMockProducer(ctrl)
+
+		alarms = make(chan struct{})
+		retryPolicy = outbox.RetryPolicy{MinBackoff: time.Second, MaxBackoff: time.Minute}
+
+		mockAlarm.EXPECT().Alarm().Return(alarms).AnyTimes()
+
+		relay = outbox.NewRelay(2, retryPolicy, mockAlarm, mockStore, mockProducer)
+		relay.Init(ctx)
+	})
+
+	AfterEach(func() {
+		relay.Close()
+		ctrl.Finish()
+	})
+
+	Context("publish all pending messages", func() {
+
+		BeforeEach(func() {
+			first := []outbox.Message{
+				{Id: 1, Event: producer.Event{Type: producer.Created}},
+				{Id: 2, Event: producer.Event{Type: producer.Updated}},
+			}
+			second := []outbox.Message{
+				{Id: 3, Event: producer.Event{Type: producer.Removed}},
+			}
+
+			gomock.InOrder(
+				mockStore.EXPECT().Acquire(gomock.Any(), 2, gomock.Any()).Return(first, nil),
+				mockStore.EXPECT().MarkSent(gomock.Any(), []uint64{1, 2}).Return(nil),
+				mockStore.EXPECT().Acquire(gomock.Any(), 2, gomock.Any()).Return(second, nil),
+				mockStore.EXPECT().MarkSent(gomock.Any(), []uint64{3}).Return(nil),
+			)
+
+			mockProducer.EXPECT().SendEvent(gomock.Any()).Return(nil).Times(3)
+		})
+
+		It("", func() {
+			alarms <- struct{}{}
+		})
+	})
+
+	Context("postpone failed messages", func() {
+
+		BeforeEach(func() {
+			messages := []outbox.Message{
+				{Id: 1, Event: producer.Event{Type: producer.Created}, Attempts: 3},
+				{Id: 2, Event: producer.Event{Type: producer.Updated}},
+			}
+
+			mockStore.EXPECT().Acquire(gomock.Any(), 2, gomock.Any()).Return(messages, nil)
+			mockStore.EXPECT().Acquire(gomock.Any(), 2, gomock.Any()).Return(nil, nil)
+
+			gomock.InOrder(
+				mockProducer.EXPECT().SendEvent(messages[0].Event).Return(errors.New("broker is unavailable")),
+				mockProducer.EXPECT().SendEvent(messages[1].Event).Return(nil),
+			)
+
+			mockStore.EXPECT().MarkFailed(gomock.Any(), uint64(1), gomock.Any(), "broker is unavailable").Return(nil)
+			mockStore.EXPECT().MarkSent(gomock.Any(), []uint64{2}).Return(nil)
+			mockStore.EXPECT().MarkSent(gomock.Any(), []uint64{}).Return(nil)
+		})
+
+		It("", func() {
+			alarms <- struct{}{}
+		})
+	})
+})
+
+var _ = Describe("RetryPolicy", func() {
+
+	retryPolicy := outbox.RetryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+
+	It("doubles backoff up to the limit", func() {
+		Expect(retryPolicy.Backoff(0)).Should(Equal(time.Second))
+		Expect(retryPolicy.Backoff(1)).Should(Equal(2 * time.Second))
+		Expect(retryPolicy.Backoff(3)).Should(Equal(8 * time.Second))
+		Expect(retryPolicy.Backoff(4)).Should(Equal(10 * time.Second))
+		Expect(retryPolicy.Backoff(100)).Should(Equal(10 * time.Second))
+	})
+})
@@ -0,0 +1,137 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+func newMockStore(t *testing.T) (*store, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: unexpected error %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &store{db: sqlx.NewDb(db, "postgres")}, mock
+}
+
+func TestPut(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	mock.ExpectExec(`INSERT INTO user_events_outbox \(event_type,payload\) VALUES \(\$1,\$2\),\(\$3,\$4\)`).
+		WithArgs(producer.Created, []byte(`{"id":1}`), producer.Removed, []byte(`{"id":2}`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err := Put(context.Background(), s.db,
+		producer.Event{Type: producer.Created, Payload: map[string]interface{}{"id": 1}},
+		producer.Event{Type: producer.Removed, Payload: map[string]interface{}{"id": 2}},
+	)
+	if err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	// Без событий запрос не выполняется.
+	if err := Put(context.Background(), s.db); err != nil {
+		t.Errorf("Empty: unexpected error %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAcquire(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	// Сообщения захватываются на время lease, а заблокированные другим экземпляром пропускаются.
+	mock.ExpectQuery(`UPDATE user_events_outbox SET next_attempt_at = now\(\) \+ make_interval\(secs => \$1\) ` +
+		`WHERE id IN \(SELECT id FROM user_events_outbox WHERE sent_at IS NULL AND next_attempt_at <= now\(\) ` +
+		`ORDER BY id LIMIT 2 FOR UPDATE SKIP LOCKED\) RETURNING id, event_type, payload, attempts`).
+		WithArgs(float64(30)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_type", "payload", "attempts"}).
+			AddRow(1, producer.Created, []byte(`{"id":5}`), 0).
+			AddRow(2, producer.Updated, []byte(`{"id":6}`), 3))
+
+	messages, err := s.Acquire(context.Background(), 2, 30*time.Second)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := []Message{
+		{Id: 1, Event: producer.Event{Type: producer.Created, Payload: map[string]interface{}{"id": float64(5)}}},
+		{Id: 2, Event: producer.Event{Type: producer.Updated, Payload: map[string]interface{}{"id": float64(6)}}, Attempts: 3},
+	}
+
+	if !reflect.DeepEqual(messages, expected) {
+		t.Errorf("expected %v, but got %v", expected, messages)
+	}
+
+	mock.ExpectQuery("UPDATE user_events_outbox").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_type", "payload", "attempts"}).
+			AddRow(3, producer.Created, []byte(`not json`), 0))
+
+	if _, err := s.Acquire(context.Background(), 2, 30*time.Second); err == nil {
+		t.Error("InvalidPayload: expected error, but got nil")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarkSent(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	mock.ExpectExec(`UPDATE user_events_outbox SET sent_at = now\(\) WHERE id = ANY\(\$1\)`).
+		WithArgs(pq.Int64Array{1, 2}).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	if err := s.MarkSent(context.Background(), []uint64{1, 2}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if err := s.MarkSent(context.Background(), nil); err != nil {
+		t.Errorf("Empty: unexpected error %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarkFailed(t *testing.T) {
+	s, mock := newMockStore(t)
+
+	retryAt := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(`UPDATE user_events_outbox SET attempts = attempts \+ 1, next_attempt_at = \$1, last_error = \$2 WHERE id = \$3`).
+		WithArgs(retryAt, "broker is unavailable", 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := s.MarkFailed(context.Background(), 7, retryAt, "broker is unavailable"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	dbError := errors.New("db error")
+	mock.ExpectExec("UPDATE user_events_outbox").WillReturnError(dbError)
+
+	if err := s.MarkFailed(context.Background(), 7, retryAt, "broker is unavailable"); !errors.Is(err, dbError) {
+		t.Errorf("expected %v, but got %v", dbError, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
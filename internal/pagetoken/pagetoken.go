@@ -0,0 +1,159 @@
+package pagetoken
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+
+	"github.com/ozoncp/ocp-user-api/internal/models"
+)
+
+const (
+	version = 1
+)
+
+var (
+	ErrMalformed      = errors.New("malformed page token")
+	ErrVersion        = errors.New("unsupported page token version")
+	ErrSignature      = errors.New("page token signature mismatch")
+	ErrParamsMismatch = errors.New("page token was issued for different search parameters")
+)
+
+// Интерфейс предназначен для выпуска и проверки непрозрачных токенов страниц списка пользователей.
+// Токен содержит позицию последнего пользователя страницы, поле сортировки и хэш фильтра,
+// подписан HMAC и не может быть использован с другими параметрами поиска.
+// Токены страниц истории изменений содержат идентификатор последней записи и привязаны к пользователю.
+type Codec interface {
+	Encode(params models.UserSearchParams, cursor models.UserCursor) (string, error)
+	Decode(token string, params models.UserSearchParams) (*models.UserCursor, error)
+	EncodeHistory(userId uint64, lastId uint64) (string, error)
+	DecodeHistory(token string, userId uint64) (uint64, error)
+}
+
+func NewCodec(secret []byte) Codec {
+	return &codec{
+		secret: secret,
+	}
+}
+
+type codec struct {
+	secret []byte
+}
+
+type payload struct {
+	SortField  models.UserSortField `json:"s"`
+	SortDesc   bool                 `json:"d,omitempty"`
+	LastId     uint64               `json:"i"`
+	LastValue  string               `json:"v,omitempty"`
+	FilterHash string               `json:"f"`
+}
+
+type historyPayload struct {
+	UserId uint64 `json:"u"`
+	LastId uint64 `json:"i"`
+}
+
+func (c *codec) Encode(params models.UserSearchParams, cursor models.UserCursor) (string, error) {
+	return c.seal(payload{
+		SortField:  params.SortField,
+		SortDesc:   params.SortDesc,
+		LastId:     cursor.Id,
+		LastValue:  cursor.Value,
+		FilterHash: filterHash(params.Filter),
+	})
+}
+
+func (c *codec) Decode(token string, params models.UserSearchParams) (*models.UserCursor, error) {
+	var position payload
+	if err := c.open(token, &position); err != nil {
+		return nil, err
+	}
+
+	if position.SortField != params.SortField ||
+		position.SortDesc != params.SortDesc ||
+		position.FilterHash != filterHash(params.Filter) {
+		return nil, ErrParamsMismatch
+	}
+
+	return &models.UserCursor{
+		Id:    position.LastId,
+		Value: position.LastValue,
+	}, nil
+}
+
+func (c *codec) EncodeHistory(userId uint64, lastId uint64) (string, error) {
+	return c.seal(historyPayload{
+		UserId: userId,
+		LastId: lastId,
+	})
+}
+
+func (c *codec) DecodeHistory(token string, userId uint64) (uint64, error) {
+	var position historyPayload
+	if err := c.open(token, &position); err != nil {
+		return 0, err
+	}
+
+	if position.UserId != userId {
+		return 0, ErrParamsMismatch
+	}
+
+	return position.LastId, nil
+}
+
+// Формат токена: base64url(версия || JSON-представление позиции || HMAC-SHA256 от предыдущих частей).
+func (c *codec) seal(position interface{}) (string, error) {
+	data, err := json.Marshal(position)
+	if err != nil {
+		return "", err
+	}
+
+	raw := make([]byte, 0, 1+len(data)+sha256.Size)
+	raw = append(raw, version)
+	raw = append(raw, data...)
+	raw = append(raw, c.sign(raw)...)
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func (c *codec) open(token string, position interface{}) error {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) <= 1+sha256.Size {
+		return ErrMalformed
+	}
+
+	if raw[0] != version {
+		return ErrVersion
+	}
+
+	body, signature := raw[:len(raw)-sha256.Size], raw[len(raw)-sha256.Size:]
+	if !hmac.Equal(signature, c.sign(body)) {
+		return ErrSignature
+	}
+
+	if err := json.Unmarshal(body[1:], position); err != nil {
+		return ErrMalformed
+	}
+
+	return nil
+}
+
+func (c *codec) sign(data []byte) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write(data)
+
+	return mac.Sum(nil)
+}
+
+func filterHash(filter models.UserSearchFilter) string {
+	var buf bytes.Buffer
+	_ = json.NewEncoder(&buf).Encode(filter)
+
+	sum := sha256.Sum256(buf.Bytes())
+
+	return hex.EncodeToString(sum[:8])
+}
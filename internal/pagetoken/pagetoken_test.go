@@ -0,0 +1,110 @@
+package pagetoken
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	"github.com/ozoncp/ocp-user-api/internal/models"
+)
+
+func TestCodec(t *testing.T) {
+	params := models.UserSearchParams{
+		Count:     10,
+		Filter:    models.UserSearchFilter{Name: "Ива"},
+		SortField: models.SortBySurname,
+	}
+	cursor := models.UserCursor{Id: 42, Value: "Иванов"}
+
+	codec := NewCodec([]byte("secret"))
+
+	token, err := codec.Encode(params, cursor)
+	if err != nil {
+		t.Fatalf("encode: unexpected error %v", err)
+	}
+
+	raw, _ := base64.RawURLEncoding.DecodeString(token)
+	tampered := append([]byte{}, raw...)
+	tampered[len(tampered)/2] ^= 0xff
+
+	otherVersion := append([]byte{}, raw...)
+	otherVersion[0] = version + 1
+
+	otherFilter := params
+	otherFilter.Filter.Email = "user@example.com"
+
+	otherSort := params
+	otherSort.SortDesc = true
+
+	cases := []struct {
+		name     string
+		codec    Codec
+		token    string
+		params   models.UserSearchParams
+		expected *models.UserCursor
+		err      error
+	}{
+		{"ValidToken", codec, token, params, &cursor, nil},
+		{"LimitMayChange", codec, token, models.UserSearchParams{Count: 50, Filter: params.Filter, SortField: params.SortField}, &cursor, nil},
+		{"NotBase64", codec, "!!!", params, nil, ErrMalformed},
+		{"TooShort", codec, base64.RawURLEncoding.EncodeToString([]byte{version}), params, nil, ErrMalformed},
+		{"Tampered", codec, base64.RawURLEncoding.EncodeToString(tampered), params, nil, ErrSignature},
+		{"UnknownVersion", codec, base64.RawURLEncoding.EncodeToString(otherVersion), params, nil, ErrVersion},
+		{"OtherSecret", NewCodec([]byte("other")), token, params, nil, ErrSignature},
+		{"OtherFilter", codec, token, otherFilter, nil, ErrParamsMismatch},
+		{"OtherSort", codec, token, otherSort, nil, ErrParamsMismatch},
+	}
+
+	for _, item := range cases {
+		actual, err := item.codec.Decode(item.token, item.params)
+
+		if err != item.err {
+			t.Errorf("%s: expected error %v, but got %v", item.name, item.err, err)
+		} else if !reflect.DeepEqual(actual, item.expected) {
+			t.Errorf("%s: expected %v, but got %v", item.name, item.expected, actual)
+		}
+	}
+}
+
+func TestHistoryCodec(t *testing.T) {
+	codec := NewCodec([]byte("secret"))
+
+	token, err := codec.EncodeHistory(7, 42)
+	if err != nil {
+		t.Fatalf("encode: unexpected error %v", err)
+	}
+
+	listToken, err := codec.Encode(models.UserSearchParams{Count: 10}, models.UserCursor{Id: 42})
+	if err != nil {
+		t.Fatalf("encode: unexpected error %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		codec    Codec
+		token    string
+		userId   uint64
+		expected uint64
+		err      error
+	}{
+		{"ValidToken", codec, token, 7, 42, nil},
+		{"NotBase64", codec, "!!!", 7, 0, ErrMalformed},
+		{"OtherSecret", NewCodec([]byte("other")), token, 7, 0, ErrSignature},
+		{"OtherUser", codec, token, 8, 0, ErrParamsMismatch},
+		{"ListToken", codec, listToken, 7, 0, ErrParamsMismatch},
+	}
+
+	for _, item := range cases {
+		actual, err := item.codec.DecodeHistory(item.token, item.userId)
+
+		if err != item.err {
+			t.Errorf("%s: expected error %v, but got %v", item.name, item.err, err)
+		} else if actual != item.expected {
+			t.Errorf("%s: expected %d, but got %d", item.name, item.expected, actual)
+		}
+	}
+
+	if _, err := codec.Decode(token, models.UserSearchParams{Count: 10}); err != ErrParamsMismatch {
+		t.Errorf("HistoryTokenAsList: expected error %v, but got %v", ErrParamsMismatch, err)
+	}
+}
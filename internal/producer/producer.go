@@ -3,6 +3,7 @@ package producer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 
 	"github.com/Shopify/sarama"
 )
@@ -13,6 +14,8 @@ type EventType = uint32
 	Created EventType = iota
  	Updated
  	Removed
+	Restored
+	Purged
  )
 
  type Event struct {
@@ -20,22 +23,27 @@ type EventType = uint32
  	Payload map[string]interface{}
  }
 
+var errNotInitialized = errors.New("producer is not initialized")
+
 type Producer interface {
 	Init(ctx context.Context) error
 	SendEvent(event Event) error
+	// Проверка доступности брокера: обновляет метаданные топика событий.
+	Ping(ctx context.Context) error
 	Close()
 }
 
-func NewProducer(broker string, topic string) Producer {
+func NewProducer(brokers []string, topic string) Producer {
 	return &producer{
-		broker: broker,
+		brokers: brokers,
 		topic: topic,
 	}
 }
 
 type producer struct {
+	client sarama.Client
 	producer sarama.SyncProducer
-	broker string
+	brokers []string
 	topic string
 }
 
@@ -45,7 +53,7 @@ func (pr *producer) Init(ctx context.Context) error {
 	cfg.Producer.RequiredAcks = sarama.WaitForAll
 	cfg.Producer.Return.Successes = true
 
-	client, err := sarama.NewClient([]string{pr.broker}, cfg)
+	client, err := sarama.NewClient(pr.brokers, cfg)
 	if err != nil {
 		return err
 	}
@@ -55,6 +63,7 @@ func (pr *producer) Init(ctx context.Context) error {
 		return err
 	}
 
+	pr.client = client
 	pr.producer = producer
 	return nil
 }
@@ -75,6 +84,15 @@ func (pr *producer) SendEvent(event Event) error {
 	return err
 }
 
+func (pr *producer) Ping(ctx context.Context) error {
+	if pr.client == nil || pr.client.Closed() {
+		return errNotInitialized
+	}
+
+	return pr.client.RefreshMetadata(pr.topic)
+}
+
 func (pr *producer) Close() {
 	pr.producer.Close()
+	pr.client.Close()
 }
@@ -0,0 +1,253 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/outbox"
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+// Результат изменения пользователя Id в групповой операции: новая версия пользователя
+// или ошибка ErrNotFound, ErrVersionMismatch.
+type ChangeResult struct {
+	Id      uint64
+	Version uint64
+	Err     error
+}
+
+// Типы изменяемых столбцов для приведения параметров в списке VALUES.
+var fieldTypes = map[models.UserField]string{
+	models.UserFieldCalendarId: "bigint",
+	models.UserFieldResumeId:   "bigint",
+	models.UserFieldName:       "text",
+	models.UserFieldSurname:    "text",
+	models.UserFieldPatronymic: "text",
+	models.UserFieldEmail:      "text",
+}
+
+func (r *repo) UpdateUsers(ctx context.Context, updates []models.UserUpdate, chunkSize int) ([]ChangeResult, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	for _, update := range updates {
+		if len(update.Fields) == 0 {
+			return nil, errors.New("no fields to update")
+		}
+	}
+
+	results := make([]ChangeResult, 0, len(updates))
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		for begin := 0; begin < len(updates); begin += chunkSize {
+			end := begin + chunkSize
+			if end > len(updates) {
+				end = len(updates)
+			}
+
+			chunkResults, err := updateUsers(ctx, tx, updates[begin:end])
+			if err != nil {
+				return err
+			}
+
+			results = append(results, chunkResults...)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func (r *repo) RemoveUsers(ctx context.Context, userIds []uint64, chunkSize int) ([]ChangeResult, error) {
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("invalid chunk size %d", chunkSize)
+	}
+
+	results := make([]ChangeResult, 0, len(userIds))
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		for begin := 0; begin < len(userIds); begin += chunkSize {
+			end := begin + chunkSize
+			if end > len(userIds) {
+				end = len(userIds)
+			}
+
+			chunkResults, err := removeUsers(ctx, tx, userIds[begin:end])
+			if err != nil {
+				return err
+			}
+
+			results = append(results, chunkResults...)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// Обновление пачки пользователей одним запросом UPDATE ... FROM (VALUES ...).
+// Для каждого поля в VALUES передается признак его изменения и новое значение.
+func updateUsers(ctx context.Context, tx *sqlx.Tx, updates []models.UserUpdate) ([]ChangeResult, error) {
+	columns := []string{"id", "expected_version"}
+	assignments := make([]string, 0, len(models.AllUserFields)+1)
+
+	for _, field := range models.AllUserFields {
+		column, _ := fieldValue(field, &models.User{})
+		columns = append(columns, "set_"+column, column)
+		assignments = append(assignments, fmt.Sprintf("%[1]s = CASE WHEN v.set_%[1]s THEN v.%[1]s ELSE u.%[1]s END", column))
+	}
+
+	assignments = append(assignments, "version = u.version + 1")
+
+	rows := make([]string, 0, len(updates))
+	args := make([]interface{}, 0, len(updates)*len(columns))
+	ids := make([]uint64, 0, len(updates))
+
+	for _, update := range updates {
+		placeholders := []string{"?::bigint", "?::bigint"}
+		args = append(args, update.User.Id, update.User.Version)
+
+		for _, field := range models.AllUserFields {
+			_, value := fieldValue(field, &update.User)
+			placeholders = append(placeholders, "?::boolean", "?::"+fieldTypes[field])
+			args = append(args, containsField(update.Fields, field), value)
+		}
+
+		rows = append(rows, "("+strings.Join(placeholders, ", ")+")")
+		ids = append(ids, update.User.Id)
+	}
+
+	query := "UPDATE " + tableName + " AS u SET " + strings.Join(assignments, ", ") +
+		" FROM (VALUES " + strings.Join(rows, ", ") + ") AS v(" + strings.Join(columns, ", ") + ")" +
+		" WHERE u.id = v.id AND u.deleted_at IS NULL AND (v.expected_version = 0 OR u.version = v.expected_version)" +
+		" " + returningUser("u")
+
+	query, err := squirrel.Dollar.ReplacePlaceholders(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return changeUsers(ctx, tx, models.UserUpdated, producer.Updated, ids, query, args...)
+}
+
+// Мягкое удаление пачки пользователей одним запросом.
+func removeUsers(ctx context.Context, tx *sqlx.Tx, userIds []uint64) ([]ChangeResult, error) {
+	query, args, err := squirrel.Update(tableName).
+		Set("deleted_at", squirrel.Expr(changeTime)).
+		Set("version", squirrel.Expr("version + 1")).
+		Where("id = ANY(?)", int64Array(userIds)).
+		Where(squirrel.Eq{"deleted_at": nil}).
+		Suffix(returningUser("")).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return changeUsers(ctx, tx, models.UserRemoved, producer.Removed, userIds, query, args...)
+}
+
+// Изменение пользователей ids запросом, возвращающим измененных пользователей, с записью событий eventType
+// и изменений operation в историю.
+// Пользователи блокируются до изменения, чтобы прочитать их предыдущее состояние.
+// Результаты возвращаются в порядке ids: неизмененный существующий пользователь означает несовпадение версии,
+// несуществующий или удаленный — ErrNotFound.
+func changeUsers(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	operation models.UserOperation,
+	eventType producer.EventType,
+	ids []uint64,
+	query string,
+	args ...interface{},
+) ([]ChangeResult, error) {
+	before, err := lockUsers(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	changed, err := scanUsers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make(map[uint64]uint64, len(changed))
+	for _, user := range changed {
+		versions[user.Id] = user.Version
+	}
+
+	results := make([]ChangeResult, 0, len(ids))
+	events := make([]producer.Event, 0, len(changed))
+
+	for _, id := range ids {
+		result := ChangeResult{Id: id}
+
+		version, isChanged := versions[id]
+		user, exists := before[id]
+
+		switch {
+		case isChanged:
+			result.Version = version
+			events = append(events, userEvent(eventType, id))
+		case exists && user.DeletedAt == nil:
+			result.Err = ErrVersionMismatch
+		default:
+			result.Err = ErrNotFound
+		}
+
+		results = append(results, result)
+	}
+
+	if err := putHistory(ctx, tx, operation, before, changed); err != nil {
+		return nil, err
+	}
+
+	if err := outbox.Put(ctx, tx, events...); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+func containsField(fields []models.UserField, field models.UserField) bool {
+	for _, item := range fields {
+		if item == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+func int64Array(ids []uint64) pq.Int64Array {
+	array := make(pq.Int64Array, 0, len(ids))
+	for _, id := range ids {
+		array = append(array, int64(id))
+	}
+
+	return array
+}
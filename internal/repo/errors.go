@@ -0,0 +1,157 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/lib/pq"
+)
+
+// Виды ошибок хранилища. Ошибки, возвращаемые методами Repo, проверяются через errors.Is и errors.As,
+// исходная ошибка драйвера доступна через errors.Unwrap.
+var (
+	ErrNotFound        = errors.New("user not found")
+	ErrVersionMismatch = errors.New("user version mismatch")
+	ErrUnavailable     = errors.New("storage is unavailable")
+	ErrTimeout         = errors.New("storage timeout")
+	// История изменений пользователя начинается не с создания, например, для пользователей,
+	// созданных до ведения истории, поэтому его прошлое состояние не может быть восстановлено.
+	ErrHistoryIncomplete = errors.New("user history is incomplete")
+)
+
+// Ошибка нарушения уникальности поля пользователя Field.
+type ConflictError struct {
+	Field string
+	err   error
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("user with the same %s already exists", e.Field)
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.err
+}
+
+// Ошибка нарушения ограничения на значение поля пользователя Field.
+type ConstraintError struct {
+	Field string
+	err   error
+}
+
+func (e *ConstraintError) Error() string {
+	return fmt.Sprintf("user %s violates constraint", e.Field)
+}
+
+func (e *ConstraintError) Unwrap() error {
+	return e.err
+}
+
+// Ошибка вида kind с сохранением исходной ошибки драйвера.
+type kindError struct {
+	kind error
+	err  error
+}
+
+func (e *kindError) Error() string {
+	return e.kind.Error() + ": " + e.err.Error()
+}
+
+func (e *kindError) Is(target error) bool {
+	return target == e.kind
+}
+
+func (e *kindError) Unwrap() error {
+	return e.err
+}
+
+const (
+	uniqueViolation      = "23505"
+	checkViolation       = "23514"
+	notNullViolation     = "23502"
+	stringDataTruncation = "22001"
+	queryCanceled        = "57014"
+
+	connectionException  = "08"
+	insufficientResource = "53"
+	operatorIntervention = "57"
+)
+
+var (
+	// Соответствие ограничений уникальности полям пользователя.
+	uniqueConstraints = map[string]string{
+		"users_email_unique_idx": "email",
+	}
+
+	// Соответствие ограничений на значения полям пользователя.
+	checkConstraints = map[string]string{
+		"users_calendar_id_check": "calendar_id",
+		"users_resume_id_check":   "resume_id",
+		"users_name_check":        "name",
+		"users_surname_check":     "surname",
+		"users_patronymic_check":  "patronymic",
+		"users_email_check":       "email",
+		"users_version_check":     "version",
+	}
+)
+
+// Преобразование ошибок драйвера в ошибки хранилища.
+func translateError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return translatePqError(pqErr, err)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &kindError{kind: ErrTimeout, err: err}
+	}
+
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, sql.ErrConnDone) {
+		return &kindError{kind: ErrUnavailable, err: err}
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return &kindError{kind: ErrTimeout, err: err}
+		}
+
+		return &kindError{kind: ErrUnavailable, err: err}
+	}
+
+	return err
+}
+
+func translatePqError(pqErr *pq.Error, err error) error {
+	switch pqErr.Code {
+	case uniqueViolation:
+		if field, exists := uniqueConstraints[pqErr.Constraint]; exists {
+			return &ConflictError{Field: field, err: err}
+		}
+	case checkViolation:
+		if field, exists := checkConstraints[pqErr.Constraint]; exists {
+			return &ConstraintError{Field: field, err: err}
+		}
+	case notNullViolation, stringDataTruncation:
+		if pqErr.Column != "" {
+			return &ConstraintError{Field: pqErr.Column, err: err}
+		}
+	case queryCanceled:
+		return &kindError{kind: ErrTimeout, err: err}
+	}
+
+	switch pqErr.Code.Class() {
+	case connectionException, insufficientResource, operatorIntervention:
+		return &kindError{kind: ErrUnavailable, err: err}
+	}
+
+	return err
+}
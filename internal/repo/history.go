@@ -0,0 +1,321 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/ozoncp/ocp-user-api/internal/audit"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+)
+
+const (
+	historyTableName = "user_history"
+
+	// Время изменения пользователя: удаления и записи в историю. Берется на момент выполнения запроса,
+	// а не начала транзакции: пользователь к этому моменту заблокирован, поэтому записи истории одного пользователя
+	// упорядочены по времени так же, как по идентификаторам, и не опережают время его удаления.
+	changeTime = "clock_timestamp()"
+)
+
+var (
+	// Столбцы пользователя, изменения которых записываются в историю.
+	historyColumns = []string{"calendar_id", "resume_id", "name", "surname", "patronymic", "email", "deleted_at"}
+
+	historyEntryColumns = []string{"id", "user_id", "operation", "actor", "trace_id", "version", "changes", "created_at"}
+)
+
+func (r *repo) ListUserHistory(ctx context.Context, userId uint64, afterId uint64, count uint64) ([]models.UserHistoryEntry, error) {
+	rows, err := squirrel.Select(historyEntryColumns...).
+		From(historyTableName).
+		Where(squirrel.Eq{"user_id": userId}).
+		Where(squirrel.Gt{"id": afterId}).
+		OrderBy("id").
+		Limit(count).
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	entries, err := scanHistory(rows)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return entries, nil
+}
+
+func (r *repo) GetUserAsOf(ctx context.Context, userId uint64, asOf time.Time) (*models.User, error) {
+	rows, err := squirrel.Select(historyEntryColumns...).
+		From(historyTableName).
+		Where(squirrel.Eq{"user_id": userId}).
+		Where(squirrel.LtOrEq{"created_at": asOf}).
+		OrderBy("id").
+		RunWith(r.db).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	entries, err := scanHistory(rows)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return replayHistory(entries)
+}
+
+func scanHistory(rows *sql.Rows) ([]models.UserHistoryEntry, error) {
+	defer rows.Close()
+
+	var entries []models.UserHistoryEntry
+
+	for rows.Next() {
+		var entry models.UserHistoryEntry
+		var changes []byte
+
+		if err := rows.Scan(
+			&entry.Id,
+			&entry.UserId,
+			&entry.Operation,
+			&entry.Actor,
+			&entry.TraceId,
+			&entry.Version,
+			&changes,
+			&entry.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(changes, &entry.Changes); err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Восстановление состояния пользователя последовательным применением изменений entries.
+// Возвращает ErrNotFound, если пользователь еще не создан или удален после последнего изменения,
+// и ErrHistoryIncomplete, если история начинается не с создания пользователя.
+func replayHistory(entries []models.UserHistoryEntry) (*models.User, error) {
+	if len(entries) == 0 {
+		return nil, ErrNotFound
+	}
+
+	if entries[0].Operation != models.UserCreated {
+		return nil, ErrHistoryIncomplete
+	}
+
+	user := &models.User{Id: entries[0].UserId}
+
+	for _, entry := range entries {
+		for column, change := range entry.Changes {
+			if err := setHistoryValue(user, column, change.After); err != nil {
+				return nil, err
+			}
+		}
+
+		user.Version = entry.Version
+	}
+
+	if user.DeletedAt != nil {
+		return nil, ErrNotFound
+	}
+
+	return user, nil
+}
+
+// Блокировка пользователей ids до конца транзакции и чтение их состояния перед изменением.
+// Строки блокируются в порядке идентификаторов, чтобы параллельные групповые изменения не взаимоблокировались.
+func lockUsers(ctx context.Context, tx *sqlx.Tx, ids []uint64) (map[uint64]models.User, error) {
+	rows, err := squirrel.Select(userColumns...).
+		From(tableName).
+		Where("id = ANY(?)", int64Array(ids)).
+		OrderBy("id").
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := scanUsers(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := make(map[uint64]models.User, len(users))
+	for _, user := range users {
+		locked[user.Id] = user
+	}
+
+	return locked, nil
+}
+
+// Запись в историю изменений пользователей after, состояния которых до изменения содержатся в before.
+// Пользователи, отсутствующие в before, считаются созданными. Инициатор и трасса берутся из контекста.
+func putHistory(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	operation models.UserOperation,
+	before map[uint64]models.User,
+	after []models.User,
+) error {
+	if len(after) == 0 {
+		return nil
+	}
+
+	actor := audit.Actor(ctx)
+	traceId := audit.TraceId(ctx)
+
+	query := squirrel.Insert(historyTableName).
+		Columns("user_id", "operation", "actor", "trace_id", "version", "changes", "created_at").
+		RunWith(tx).
+		PlaceholderFormat(squirrel.Dollar)
+
+	for i := range after {
+		var previous *models.User
+		if user, exists := before[after[i].Id]; exists {
+			previous = &user
+		}
+
+		changes, err := json.Marshal(userDiff(previous, &after[i]))
+		if err != nil {
+			return err
+		}
+
+		query = query.Values(
+			after[i].Id, string(operation), actor, traceId, after[i].Version, string(changes), squirrel.Expr(changeTime),
+		)
+	}
+
+	_, err := query.ExecContext(ctx)
+	return err
+}
+
+// Изменения полей пользователя from -> to. Если from равен nil, все заданные поля to считаются измененными.
+func userDiff(from *models.User, to *models.User) map[string]models.FieldChange {
+	var before map[string]*string
+	if from != nil {
+		before = historyValues(from)
+	}
+
+	after := historyValues(to)
+	changes := make(map[string]models.FieldChange)
+
+	for _, column := range historyColumns {
+		if equalValues(before[column], after[column]) {
+			continue
+		}
+
+		changes[column] = models.FieldChange{
+			Before: before[column],
+			After:  after[column],
+		}
+	}
+
+	return changes
+}
+
+// Текстовые значения полей пользователя, записываемых в историю.
+func historyValues(user *models.User) map[string]*string {
+	text := func(value string) *string {
+		return &value
+	}
+
+	values := map[string]*string{
+		"calendar_id": text(strconv.FormatUint(user.CalendarId, 10)),
+		"resume_id":   text(strconv.FormatUint(user.ResumeId, 10)),
+		"name":        text(user.Name),
+		"surname":     text(user.Surname),
+		"patronymic":  text(user.Patronymic),
+		"email":       text(user.Email),
+		"deleted_at":  nil,
+	}
+
+	if user.DeletedAt != nil {
+		values["deleted_at"] = text(user.DeletedAt.UTC().Format(time.RFC3339Nano))
+	}
+
+	return values
+}
+
+// Запись в поле пользователя, соответствующее столбцу column, значения в текстовом виде из истории.
+func setHistoryValue(user *models.User, column string, value *string) error {
+	var text string
+	if value != nil {
+		text = *value
+	}
+
+	var err error
+
+	switch column {
+	case "calendar_id":
+		user.CalendarId, err = strconv.ParseUint(text, 10, 64)
+	case "resume_id":
+		user.ResumeId, err = strconv.ParseUint(text, 10, 64)
+	case "name":
+		user.Name = text
+	case "surname":
+		user.Surname = text
+	case "patronymic":
+		user.Patronymic = text
+	case "email":
+		user.Email = text
+	case "deleted_at":
+		user.DeletedAt = nil
+
+		if value != nil {
+			var deletedAt time.Time
+			if deletedAt, err = time.Parse(time.RFC3339Nano, text); err == nil {
+				user.DeletedAt = &deletedAt
+			}
+		}
+	default:
+		err = fmt.Errorf("unknown history column %q", column)
+	}
+
+	return err
+}
+
+func equalValues(a *string, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// Выражение RETURNING со всеми столбцами пользователя, при необходимости с псевдонимом таблицы alias.
+func returningUser(alias string) string {
+	columns := userColumns
+	if alias != "" {
+		columns = make([]string, 0, len(userColumns))
+		for _, column := range userColumns {
+			columns = append(columns, alias+"."+column)
+		}
+	}
+
+	return "RETURNING " + strings.Join(columns, ", ")
+}
@@ -4,29 +4,82 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/outbox"
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+	"github.com/ozoncp/ocp-user-api/internal/utils"
 )
 
 const (
-	tableName = "tasks"
+	tableName = "users"
 )
 
 var (
-	errNotImplemented = errors.New("not implemented")
+	userColumns = []string{"id", "calendar_id", "resume_id", "name", "surname", "patronymic", "email", "version", "deleted_at"}
+
+	sortColumns = map[models.UserSortField]string{
+		models.SortById:         "id",
+		models.SortByName:       "name",
+		models.SortBySurname:    "surname",
+		models.SortByPatronymic: "patronymic",
+		models.SortByEmail:      "email",
+		models.SortByCalendarId: "calendar_id",
+		models.SortByResumeId:   "resume_id",
+	}
+
+	likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
 )
 
+// Хранилище пользователей. Адреса электронной почты нормализуются при записи и должны быть уникальны
+// среди неудаленных пользователей, при нарушении уникальности возвращается *ConflictError.
 type Repo interface {
 	CreateUser(ctx context.Context, user *models.User) (uint64, error)
 	CreateUsers(ctx context.Context, users []models.User) ([]uint64, error)
-	UpdateUser(ctx context.Context, user *models.User) (bool, error)
-	RemoveUser(ctx context.Context, userId uint64) (bool, error)
+	// Создание пользователей в одной транзакции: вставка выполняется пачками по chunkSize пользователей,
+	// при ошибке в любой пачке не создается ни один пользователь.
+	CreateUsersAtomic(ctx context.Context, users []models.User, chunkSize int) ([]uint64, error)
+	// Обновление полей fields пользователя user.Id значениями из user.
+	// Если user.Version не равно нулю, обновление выполняется только для этой версии пользователя,
+	// иначе возвращается ErrVersionMismatch. При успешном обновлении в user.Version записывается новая версия.
+	UpdateUser(ctx context.Context, user *models.User, fields []models.UserField) (bool, error)
+	// Мягкое удаление пользователя: пользователь помечается удаленным и скрывается из выборок.
+	// Если expectedVersion не равно нулю, удаляется только эта версия пользователя,
+	// иначе возвращается ErrVersionMismatch.
+	RemoveUser(ctx context.Context, userId uint64, expectedVersion uint64) (bool, error)
+	// Обновление пользователей в одной транзакции пачками по chunkSize. Результаты возвращаются в порядке updates:
+	// новая версия пользователя, ErrNotFound или ErrVersionMismatch. Ошибка выполнения запроса отменяет все изменения.
+	UpdateUsers(ctx context.Context, updates []models.UserUpdate, chunkSize int) ([]ChangeResult, error)
+	// Мягкое удаление пользователей в одной транзакции пачками по chunkSize. Результаты возвращаются в порядке userIds:
+	// новая версия пользователя или ErrNotFound. Ошибка выполнения запроса отменяет все изменения.
+	RemoveUsers(ctx context.Context, userIds []uint64, chunkSize int) ([]ChangeResult, error)
+	// Восстановление удаленного пользователя.
+	RestoreUser(ctx context.Context, userId uint64) (bool, error)
+	// Окончательное удаление пользователей, удаленных ранее deletedBefore. Возвращает число удаленных записей.
+	PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (uint64, error)
+	// Извлечение пользователя. Для отсутствующих и удаленных пользователей возвращается ErrNotFound.
 	GetUser(ctx context.Context, userId uint64) (*models.User, error)
+	// Состояние пользователя на момент asOf, восстановленное по истории изменений. Если пользователь на этот момент
+	// еще не создан или уже удален, возвращается ErrNotFound, если история неполна — ErrHistoryIncomplete.
+	GetUserAsOf(ctx context.Context, userId uint64, asOf time.Time) (*models.User, error)
 	GetUsers(ctx context.Context, userIds []uint64) ([]models.User, error)
 	SearchUsers(ctx context.Context, params models.UserSearchParams) (*models.UserSearchResult, error)
+	// Выгрузка пользователей, удовлетворяющих params.Filter, в порядке сортировки params из согласованного снимка БД.
+	// Пользователи читаются курсором пачками по batchSize и передаются в fn; params.Count не учитывается.
+	// Ошибка fn прерывает выгрузку и возвращается без изменений.
+	ExportUsers(ctx context.Context, params models.UserSearchParams, batchSize int, fn func(users []models.User) error) error
+	// История изменений пользователя: не более count записей с идентификаторами больше afterId в порядке изменений.
+	// История сохраняется и после окончательного удаления пользователя.
+	ListUserHistory(ctx context.Context, userId uint64, afterId uint64, count uint64) ([]models.UserHistoryEntry, error)
+	Ping(ctx context.Context) error
 }
 
 func NewRepo(
@@ -40,24 +93,51 @@ type repo struct {
 }
 
 func (r *repo) CreateUser(ctx context.Context, user *models.User) (uint64, error) {
-	query := squirrel.Insert(tableName).
-		Columns("calendar", "resume", "name", "surname", "patronymic", "email").
-		Values(user.CalendarId, user.ResumeId, user.Name, user.Surname, user.Patronymic, user.Email).
-		Suffix("RETURNING \"id\"").
-		RunWith(r.db).
-		PlaceholderFormat(squirrel.Dollar)
+	user.Email = normalizeEmail(user.Email)
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		rows, err := squirrel.Insert(tableName).
+			Columns("calendar_id", "resume_id", "name", "surname", "patronymic", "email").
+			Values(user.CalendarId, user.ResumeId, user.Name, user.Surname, user.Patronymic, user.Email).
+			Suffix(returningUser("")).
+			RunWith(tx).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		created, err := scanUsers(rows)
+		if err != nil {
+			return err
+		}
 
-	if query.QueryRow().Scan(&user.Id) != nil {
-		return 0, nil
+		if len(created) == 0 {
+			return sql.ErrNoRows
+		}
+
+		user.Id = created[0].Id
+		user.Version = created[0].Version
+
+		if err := putHistory(ctx, tx, models.UserCreated, nil, created); err != nil {
+			return err
+		}
+
+		return outbox.Put(ctx, tx, userEvent(producer.Created, user.Id))
+	})
+
+	if err != nil {
+		return 0, err
 	}
 
 	return user.Id, nil
 }
 
 func (r *repo) GetUser(ctx context.Context, userId uint64) (*models.User, error) {
-	queryBuilder := squirrel.Select("id", "calendar", "resume", "name", "surname", "patronymic", "email").
+	queryBuilder := squirrel.Select(userColumns...).
 		From(tableName).
-		Where(squirrel.Eq{"id": userId}).
+		Where(squirrel.Eq{"id": userId, "deleted_at": nil}).
 		RunWith(r.db).
 		PlaceholderFormat(squirrel.Dollar)
 
@@ -66,51 +146,298 @@ func (r *repo) GetUser(ctx context.Context, userId uint64) (*models.User, error)
 		return nil, err
 	}
 
-	var users []*models.User
-	if err = r.db.SelectContext(ctx, &users, query, args...); err != nil {
+	user := &models.User{}
+	if err = r.db.GetContext(ctx, user, query, args...); err != nil {
 		if err == sql.ErrNoRows {
-			return nil, nil
+			return nil, ErrNotFound
+		}
+
+		return nil, translateError(err)
+	}
+
+	return user, nil
+}
+
+func (r *repo) RemoveUser(ctx context.Context, userId uint64, expectedVersion uint64) (bool, error) {
+	var isDeleted bool
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := squirrel.Update(tableName).
+			Set("deleted_at", squirrel.Expr(changeTime)).
+			Set("version", squirrel.Expr("version + 1")).
+			Where(squirrel.Eq{"id": userId, "deleted_at": nil})
+
+		if expectedVersion != 0 {
+			query = query.Where(squirrel.Eq{"version": expectedVersion})
+		}
+
+		removed, err := changeUser(ctx, tx, models.UserRemoved, userId, query)
+		if err != nil {
+			return err
+		}
+
+		if removed == nil {
+			return checkVersionMismatch(ctx, tx, userId, expectedVersion)
+		}
+
+		isDeleted = true
+		return outbox.Put(ctx, tx, userEvent(producer.Removed, userId))
+	})
+
+	return isDeleted, err
+}
+
+func (r *repo) RestoreUser(ctx context.Context, userId uint64) (bool, error) {
+	var isRestored bool
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := squirrel.Update(tableName).
+			Set("deleted_at", nil).
+			Set("version", squirrel.Expr("version + 1")).
+			Where(squirrel.And{
+				squirrel.Eq{"id": userId},
+				squirrel.NotEq{"deleted_at": nil},
+			})
+
+		restored, err := changeUser(ctx, tx, models.UserRestored, userId, query)
+		if err != nil || restored == nil {
+			return err
 		}
 
+		isRestored = true
+		return outbox.Put(ctx, tx, userEvent(producer.Restored, userId))
+	})
+
+	return isRestored, err
+}
+
+// Изменение пользователя userId запросом query с записью изменения operation в историю.
+// Пользователь блокируется до изменения, чтобы прочитать его предыдущее состояние.
+// Возвращает измененного пользователя или nil, если запрос не изменил пользователя.
+func changeUser(
+	ctx context.Context,
+	tx *sqlx.Tx,
+	operation models.UserOperation,
+	userId uint64,
+	query squirrel.UpdateBuilder,
+) (*models.User, error) {
+	before, err := lockUsers(ctx, tx, []uint64{userId})
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
+		Suffix(returningUser("")).
+		RunWith(tx).
+		PlaceholderFormat(squirrel.Dollar).
+		QueryContext(ctx)
+
+	if err != nil {
 		return nil, err
 	}
 
-	var user *models.User
-	if len(users) > 0 {
-		user = users[0]
+	changed, err := scanUsers(rows)
+	if err != nil {
+		return nil, err
 	}
 
-	return user, nil
+	if len(changed) == 0 {
+		return nil, nil
+	}
+
+	if err := putHistory(ctx, tx, operation, before, changed); err != nil {
+		return nil, err
+	}
+
+	return &changed[0], nil
 }
 
-func (r *repo) RemoveUser(ctx context.Context, userId uint64) (bool, error) {
-	query := squirrel.Delete(tableName).
-		Where(squirrel.Eq{"id": userId}).
-		RunWith(r.db).
-		PlaceholderFormat(squirrel.Dollar)
+func (r *repo) PurgeDeletedUsers(ctx context.Context, deletedBefore time.Time) (uint64, error) {
+	var purged uint64
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		rows, err := squirrel.Delete(tableName).
+			Where(squirrel.Lt{"deleted_at": deletedBefore}).
+			Suffix("RETURNING \"id\"").
+			RunWith(tx).
+			PlaceholderFormat(squirrel.Dollar).
+			QueryContext(ctx)
+
+		if err != nil {
+			return err
+		}
+
+		defer rows.Close()
+
+		var events []producer.Event
+
+		for rows.Next() {
+			var id uint64
+			if err := rows.Scan(&id); err != nil {
+				return err
+			}
+
+			events = append(events, userEvent(producer.Purged, id))
+		}
+
+		if err := rows.Err(); err != nil {
+			return err
+		}
+
+		purged = uint64(len(events))
+		return outbox.Put(ctx, tx, events...)
+	})
 
-	res, err := query.ExecContext(ctx)
 	if err != nil {
-		return false, err
+		return 0, err
 	}
 
-	rows, err := res.RowsAffected()
-	return rows != 0, err
+	return purged, nil
 }
 
 func (r *repo) SearchUsers(ctx context.Context, params models.UserSearchParams) (*models.UserSearchResult, error) {
-	query := squirrel.Select("id", "calendar", "resume", "name", "surname", "patronymic", "email").
-		From(tableName).
-		Where(squirrel.Gt{"id": params.Offset}).
+	query, err := searchQuery(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := query.
 		RunWith(r.db).
 		Limit(params.Count).
-		PlaceholderFormat(squirrel.Dollar)
+		QueryContext(ctx)
 
-	rows, err := query.QueryContext(ctx)
 	if err != nil {
-		return nil, err
+		return nil, translateError(err)
+	}
+
+	users, err := scanUsers(rows)
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	var next *models.UserCursor
+
+	if len(users) > 0 && uint64(len(users)) == params.Count {
+		last := users[len(users)-1]
+		next = &models.UserCursor{
+			Id:    last.Id,
+			Value: sortValue(params.SortField, &last),
+		}
+	}
+
+	return &models.UserSearchResult{
+		Items: users,
+		Next:  next,
+	}, nil
+}
+
+func (r *repo) ExportUsers(
+	ctx context.Context,
+	params models.UserSearchParams,
+	batchSize int,
+	fn func(users []models.User) error,
+) error {
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size %d", batchSize)
+	}
+
+	query, err := searchQuery(params)
+	if err != nil {
+		return err
+	}
+
+	sqlQuery, args, err := query.ToSql()
+	if err != nil {
+		return err
+	}
+
+	// Все пачки читаются из одного снимка БД, поэтому параллельные изменения не приводят
+	// к пропуску или повторной выдаче пользователей.
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return translateError(err)
 	}
 
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err := tx.ExecContext(ctx, "DECLARE users_export NO SCROLL CURSOR FOR "+sqlQuery, args...); err != nil {
+		return translateError(err)
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM users_export", batchSize)
+
+	for {
+		rows, err := tx.QueryContext(ctx, fetch)
+		if err != nil {
+			return translateError(err)
+		}
+
+		users, err := scanUsers(rows)
+		if err != nil {
+			return translateError(err)
+		}
+
+		if len(users) > 0 {
+			if err := fn(users); err != nil {
+				return err
+			}
+		}
+
+		if len(users) < batchSize {
+			break
+		}
+	}
+
+	return translateError(tx.Commit())
+}
+
+// Запрос пользователей, удовлетворяющих фильтру, в порядке сортировки params, начиная после позиции params.After.
+func searchQuery(params models.UserSearchParams) (squirrel.SelectBuilder, error) {
+	sortColumn, exists := sortColumns[params.SortField]
+	if !exists {
+		return squirrel.SelectBuilder{}, fmt.Errorf("unknown sort field %d", params.SortField)
+	}
+
+	query := squirrel.Select(userColumns...).
+		From(tableName).
+		Where(searchFilter(params.Filter)).
+		PlaceholderFormat(squirrel.Dollar)
+
+	direction := "ASC"
+	compare := ">"
+
+	if params.SortDesc {
+		direction = "DESC"
+		compare = "<"
+	}
+
+	// Пагинация по ключу: следующая страница начинается строго после позиции After
+	// в заданном порядке сортировки, поэтому страницы не смещаются при вставке и удалении записей.
+	if params.After != nil {
+		if sortColumn == "id" {
+			query = query.Where(fmt.Sprintf("id %s ?", compare), params.After.Id)
+		} else {
+			value, err := cursorValue(params.SortField, params.After.Value)
+			if err != nil {
+				return squirrel.SelectBuilder{}, err
+			}
+
+			query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", sortColumn, compare), value, params.After.Id)
+		}
+	}
+
+	if sortColumn == "id" {
+		query = query.OrderBy("id " + direction)
+	} else {
+		query = query.OrderBy(sortColumn+" "+direction, "id "+direction)
+	}
+
+	return query, nil
+}
+
+func scanUsers(rows *sql.Rows) ([]models.User, error) {
 	defer rows.Close()
 
 	var users []models.User
@@ -126,6 +453,8 @@ func (r *repo) SearchUsers(ctx context.Context, params models.UserSearchParams)
 			&user.Surname,
 			&user.Patronymic,
 			&user.Email,
+			&user.Version,
+			&user.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -133,73 +462,324 @@ func (r *repo) SearchUsers(ctx context.Context, params models.UserSearchParams)
 		users = append(users, user)
 	}
 
-	nextOffset := params.Offset
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func fieldValue(field models.UserField, user *models.User) (string, interface{}) {
+	switch field {
+	case models.UserFieldCalendarId:
+		return "calendar_id", user.CalendarId
+	case models.UserFieldResumeId:
+		return "resume_id", user.ResumeId
+	case models.UserFieldName:
+		return "name", user.Name
+	case models.UserFieldSurname:
+		return "surname", user.Surname
+	case models.UserFieldPatronymic:
+		return "patronymic", user.Patronymic
+	case models.UserFieldEmail:
+		return "email", normalizeEmail(user.Email)
+	default:
+		return "", nil
+	}
+}
 
-	if len(users) > 0 {
-		nextOffset = users[len(users)-1].Id
+func sortValue(field models.UserSortField, user *models.User) string {
+	switch field {
+	case models.SortByName:
+		return user.Name
+	case models.SortBySurname:
+		return user.Surname
+	case models.SortByPatronymic:
+		return user.Patronymic
+	case models.SortByEmail:
+		return user.Email
+	case models.SortByCalendarId:
+		return strconv.FormatUint(user.CalendarId, 10)
+	case models.SortByResumeId:
+		return strconv.FormatUint(user.ResumeId, 10)
+	default:
+		return ""
 	}
+}
 
-	return &models.UserSearchResult{
-		Items:      users,
-		NextOffset: nextOffset,
-	}, errNotImplemented
+func cursorValue(field models.UserSortField, value string) (interface{}, error) {
+	switch field {
+	case models.SortByCalendarId, models.SortByResumeId:
+		return strconv.ParseUint(value, 10, 64)
+	default:
+		return value, nil
+	}
+}
+
+func searchFilter(filter models.UserSearchFilter) squirrel.And {
+	conditions := squirrel.And{}
+
+	prefixes := []struct {
+		column string
+		value  string
+	}{
+		{"name", filter.Name},
+		{"surname", filter.Surname},
+		{"patronymic", filter.Patronymic},
+	}
+
+	for _, prefix := range prefixes {
+		if prefix.value != "" {
+			conditions = append(conditions, squirrel.Like{
+				"lower(" + prefix.column + ")": strings.ToLower(likeEscaper.Replace(prefix.value)) + "%",
+			})
+		}
+	}
+
+	if filter.Email != "" {
+		conditions = append(conditions, squirrel.Eq{"email": normalizeEmail(filter.Email)})
+	}
+
+	if filter.CalendarId != 0 {
+		conditions = append(conditions, squirrel.Eq{"calendar_id": filter.CalendarId})
+	}
+
+	if filter.ResumeId != 0 {
+		conditions = append(conditions, squirrel.Eq{"resume_id": filter.ResumeId})
+	}
+
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, squirrel.Eq{"deleted_at": nil})
+	}
+
+	return conditions
 }
 
 func (r *repo) CreateUsers(ctx context.Context, users []models.User) ([]uint64, error) {
+	var ids []uint64
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		var err error
+		ids, err = insertUsers(ctx, tx, users)
+
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+func (r *repo) CreateUsersAtomic(ctx context.Context, users []models.User, chunkSize int) ([]uint64, error) {
+	chunks, err := utils.SplitToChunks(users, chunkSize)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(users))
+
+	err = r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		for _, chunk := range chunks {
+			chunkIds, err := insertUsers(ctx, tx, chunk)
+			if err != nil {
+				return err
+			}
+
+			ids = append(ids, chunkIds...)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// Вставка пользователей одним запросом в транзакции tx с записью событий создания и истории.
+// Идентификаторы возвращаются в порядке пользователей.
+func insertUsers(ctx context.Context, tx *sqlx.Tx, users []models.User) ([]uint64, error) {
+	if len(users) == 0 {
+		return []uint64{}, nil
+	}
+
 	query := squirrel.Insert(tableName).
-		Columns("calendar", "resume", "name", "surname", "patronymic", "email").
-		Suffix("RETURNING \"id\"").
-		RunWith(r.db).
+		Columns("calendar_id", "resume_id", "name", "surname", "patronymic", "email").
+		Suffix(returningUser("")).
+		RunWith(tx).
 		PlaceholderFormat(squirrel.Dollar)
 
 	for _, user := range users {
-		query = query.Values(user.CalendarId, user.ResumeId, user.Name, user.Surname, user.Patronymic, user.Email)
+		query = query.Values(
+			user.CalendarId, user.ResumeId, user.Name, user.Surname, user.Patronymic, normalizeEmail(user.Email),
+		)
 	}
 
-	rows, err := query.Query()
+	rows, err := query.QueryContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	defer rows.Close()
+	created, err := scanUsers(rows)
+	if err != nil {
+		return nil, err
+	}
 
-	ids := make([]uint64, 0, len(users))
-	var id uint64
+	ids := make([]uint64, 0, len(created))
+	for _, user := range created {
+		ids = append(ids, user.Id)
+	}
 
-	for rows.Next() {
-		if err := rows.Scan(&id); err != nil {
-			return ids, err
-		}
+	if err := putHistory(ctx, tx, models.UserCreated, nil, created); err != nil {
+		return nil, err
+	}
 
-		ids = append(ids, id)
+	events := make([]producer.Event, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, userEvent(producer.Created, id))
+	}
+
+	if err := outbox.Put(ctx, tx, events...); err != nil {
+		return nil, err
 	}
 
 	return ids, nil
 }
 
-func (r *repo) UpdateUser(ctx context.Context, user *models.User) (bool, error) {
-	query := squirrel.Update(tableName).
-		SetMap(map[string]interface{}{
-			"calendar":   user.CalendarId,
-			"resume":     user.ResumeId,
-			"name":       user.Name,
-			"surname":    user.Surname,
-			"patronymic": user.Patronymic,
-			"email":      user.Email,
-		}).
-		Where(squirrel.Eq{"id": user.Id}).
-		RunWith(r.db).
-		PlaceholderFormat(squirrel.Dollar)
+func (r *repo) UpdateUser(ctx context.Context, user *models.User, fields []models.UserField) (bool, error) {
+	if len(fields) == 0 {
+		return false, errors.New("no fields to update")
+	}
+
+	values := make(map[string]interface{}, len(fields)+1)
+	for _, field := range fields {
+		column, value := fieldValue(field, user)
+		if column == "" {
+			return false, fmt.Errorf("unknown user field %d", field)
+		}
+
+		values[column] = value
+	}
+
+	values["version"] = squirrel.Expr("version + 1")
+
+	var isUpdated bool
+
+	err := r.inTransaction(ctx, func(tx *sqlx.Tx) error {
+		query := squirrel.Update(tableName).
+			SetMap(values).
+			Where(squirrel.Eq{"id": user.Id, "deleted_at": nil})
+
+		if user.Version != 0 {
+			query = query.Where(squirrel.Eq{"version": user.Version})
+		}
+
+		updated, err := changeUser(ctx, tx, models.UserUpdated, user.Id, query)
+		if err != nil {
+			return err
+		}
+
+		if updated == nil {
+			return checkVersionMismatch(ctx, tx, user.Id, user.Version)
+		}
+
+		user.Version = updated.Version
+		isUpdated = true
+
+		return outbox.Put(ctx, tx, userEvent(producer.Updated, user.Id))
+	})
+
+	return isUpdated, err
+}
+
+// Проверка причины, по которой условное изменение пользователя не затронуло ни одной строки.
+// Возвращает ErrVersionMismatch, если пользователь существует и не удален, но его версия отличается от ожидаемой.
+func checkVersionMismatch(ctx context.Context, tx *sqlx.Tx, userId uint64, expectedVersion uint64) error {
+	if expectedVersion == 0 {
+		return nil
+	}
+
+	var exists bool
+
+	query := "SELECT EXISTS (SELECT 1 FROM " + tableName + " WHERE id = $1 AND deleted_at IS NULL)"
 
-	res, err := query.ExecContext(ctx)
+	err := tx.QueryRowxContext(ctx, query, userId).Scan(&exists)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	rows, err := res.RowsAffected()
-	return rows != 0, err
+	if exists {
+		return ErrVersionMismatch
+	}
+
+	return nil
 }
 
+// Извлечение пользователей одним запросом по списку идентификаторов.
+// Порядок пользователей в результате не гарантируется, отсутствующие и удаленные идентификаторы пропускаются.
 func (r *repo) GetUsers(ctx context.Context, userIds []uint64) ([]models.User, error) {
-	return nil, errNotImplemented
+	if len(userIds) == 0 {
+		return []models.User{}, nil
+	}
+
+	ids := make(pq.Int64Array, 0, len(userIds))
+	for _, userId := range userIds {
+		ids = append(ids, int64(userId))
+	}
+
+	queryBuilder := squirrel.Select(userColumns...).
+		From(tableName).
+		Where("id = ANY(?)", ids).
+		Where(squirrel.Eq{"deleted_at": nil}).
+		PlaceholderFormat(squirrel.Dollar)
+
+	query, args, err := queryBuilder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]models.User, 0, len(userIds))
+	if err = r.db.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, translateError(err)
+	}
+
+	return users, nil
+}
+
+// Проверка доступности хранилища.
+func (r *repo) Ping(ctx context.Context) error {
+	return translateError(r.db.PingContext(ctx))
+}
+
+// Выполнение fn в транзакции. Транзакция откатывается, если fn вернула ошибку.
+func (r *repo) inTransaction(ctx context.Context, fn func(tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return translateError(err)
+	}
+
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return translateError(err)
+	}
+
+	return translateError(tx.Commit())
+}
+
+// Адреса электронной почты сравниваются без учета регистра и окружающих пробелов.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func userEvent(eventType producer.EventType, userId uint64) producer.Event {
+	return producer.Event{
+		Type: eventType,
+		Payload: map[string]interface{}{
+			"Id": userId,
+		},
+	}
 }
@@ -0,0 +1,895 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/ozoncp/ocp-user-api/internal/audit"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/producer"
+)
+
+func TestTranslateError(t *testing.T) {
+	other := errors.New("other")
+
+	cases := []struct {
+		name  string
+		err   error
+		field string
+	}{
+		{"Nil", nil, ""},
+		{"Other", other, ""},
+		{"EmailConflict", &pq.Error{Code: uniqueViolation, Constraint: "users_email_unique_idx"}, "email"},
+		{"UnknownConstraint", &pq.Error{Code: uniqueViolation, Constraint: "users_pkey"}, ""},
+		{"UnknownCheck", &pq.Error{Code: checkViolation, Constraint: "users_other_check"}, ""},
+	}
+
+	for _, item := range cases {
+		err := translateError(item.err)
+
+		var conflict *ConflictError
+		if errors.As(err, &conflict) {
+			if conflict.Field != item.field {
+				t.Errorf("%s: expected conflict on %q, but got %q", item.name, item.field, conflict.Field)
+			}
+
+			continue
+		}
+
+		if item.field != "" {
+			t.Errorf("%s: expected conflict on %q, but got %v", item.name, item.field, err)
+		}
+
+		if err != item.err {
+			t.Errorf("%s: expected error %v to be returned as is, but got %v", item.name, item.err, err)
+		}
+	}
+}
+
+func TestTranslateErrorKind(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		kind error
+	}{
+		{"Deadline", context.DeadlineExceeded, ErrTimeout},
+		{"StatementTimeout", &pq.Error{Code: queryCanceled}, ErrTimeout},
+		{"BadConn", driver.ErrBadConn, ErrUnavailable},
+		{"ConnectionFailure", &pq.Error{Code: "08006"}, ErrUnavailable},
+		{"AdminShutdown", &pq.Error{Code: "57P01"}, ErrUnavailable},
+	}
+
+	for _, item := range cases {
+		err := translateError(item.err)
+
+		if !errors.Is(err, item.kind) {
+			t.Errorf("%s: expected %v, but got %v", item.name, item.kind, err)
+		}
+
+		if !errors.Is(err, item.err) {
+			t.Errorf("%s: expected original error to be wrapped", item.name)
+		}
+	}
+
+	constraint := &pq.Error{Code: checkViolation, Constraint: "users_name_check"}
+
+	var constraintErr *ConstraintError
+	if !errors.As(translateError(constraint), &constraintErr) || constraintErr.Field != "name" {
+		t.Errorf("expected constraint violation on name, but got %v", translateError(constraint))
+	}
+}
+
+func TestNormalizeEmail(t *testing.T) {
+	if actual := normalizeEmail("  User@Example.COM "); actual != "user@example.com" {
+		t.Errorf("unexpected normalized email %q", actual)
+	}
+}
+
+func TestUserDiff(t *testing.T) {
+	text := func(value string) *string {
+		return &value
+	}
+
+	deletedAt := time.Date(2021, 6, 1, 12, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+
+	user := models.User{Id: 1, CalendarId: 2, ResumeId: 3, Name: "Иван", Surname: "Иванов", Version: 1}
+
+	renamed := user
+	renamed.Name = "Петр"
+	renamed.Version = 2
+
+	removed := user
+	removed.DeletedAt = &deletedAt
+	removed.Version = 2
+
+	cases := []struct {
+		name     string
+		from     *models.User
+		to       *models.User
+		expected map[string]models.FieldChange
+	}{
+		{"Created", nil, &user, map[string]models.FieldChange{
+			"calendar_id": {After: text("2")},
+			"resume_id":   {After: text("3")},
+			"name":        {After: text("Иван")},
+			"surname":     {After: text("Иванов")},
+			"patronymic":  {After: text("")},
+			"email":       {After: text("")},
+		}},
+		{"Updated", &user, &renamed, map[string]models.FieldChange{
+			"name": {Before: text("Иван"), After: text("Петр")},
+		}},
+		{"Removed", &user, &removed, map[string]models.FieldChange{
+			"deleted_at": {After: text("2021-06-01T09:30:00Z")},
+		}},
+		{"Restored", &removed, &user, map[string]models.FieldChange{
+			"deleted_at": {Before: text("2021-06-01T09:30:00Z")},
+		}},
+		{"Unchanged", &user, &user, map[string]models.FieldChange{}},
+	}
+
+	for _, item := range cases {
+		if actual := userDiff(item.from, item.to); !reflect.DeepEqual(actual, item.expected) {
+			t.Errorf("%s: expected %v, but got %v", item.name, item.expected, actual)
+		}
+	}
+}
+
+func TestReplayHistory(t *testing.T) {
+	text := func(value string) *string {
+		return &value
+	}
+
+	created := models.UserHistoryEntry{UserId: 1, Operation: models.UserCreated, Version: 1, Changes: map[string]models.FieldChange{
+		"calendar_id": {After: text("2")},
+		"resume_id":   {After: text("3")},
+		"name":        {After: text("Иван")},
+		"surname":     {After: text("Иванов")},
+		"patronymic":  {After: text("")},
+		"email":       {After: text("ivan@example.com")},
+	}}
+	updated := models.UserHistoryEntry{UserId: 1, Operation: models.UserUpdated, Version: 2, Changes: map[string]models.FieldChange{
+		"name": {Before: text("Иван"), After: text("Петр")},
+	}}
+	removed := models.UserHistoryEntry{UserId: 1, Operation: models.UserRemoved, Version: 3, Changes: map[string]models.FieldChange{
+		"deleted_at": {After: text("2021-06-01T09:30:00Z")},
+	}}
+	restored := models.UserHistoryEntry{UserId: 1, Operation: models.UserRestored, Version: 4, Changes: map[string]models.FieldChange{
+		"deleted_at": {Before: text("2021-06-01T09:30:00Z")},
+	}}
+	unknown := models.UserHistoryEntry{UserId: 1, Operation: models.UserUpdated, Version: 2, Changes: map[string]models.FieldChange{
+		"login": {After: text("ivan")},
+	}}
+
+	user := models.User{Id: 1, CalendarId: 2, ResumeId: 3, Name: "Петр", Surname: "Иванов", Email: "ivan@example.com", Version: 2}
+
+	restoredUser := user
+	restoredUser.Version = 4
+
+	cases := []struct {
+		name     string
+		entries  []models.UserHistoryEntry
+		expected *models.User
+		err      error
+	}{
+		{"NotCreated", nil, nil, ErrNotFound},
+		{"Updated", []models.UserHistoryEntry{created, updated}, &user, nil},
+		{"Removed", []models.UserHistoryEntry{created, updated, removed}, nil, ErrNotFound},
+		{"Restored", []models.UserHistoryEntry{created, updated, removed, restored}, &restoredUser, nil},
+		{"Incomplete", []models.UserHistoryEntry{updated}, nil, ErrHistoryIncomplete},
+	}
+
+	for _, item := range cases {
+		actual, err := replayHistory(item.entries)
+
+		if err != item.err {
+			t.Errorf("%s: expected error %v, but got %v", item.name, item.err, err)
+		} else if !reflect.DeepEqual(actual, item.expected) {
+			t.Errorf("%s: expected %+v, but got %+v", item.name, item.expected, actual)
+		}
+	}
+
+	if _, err := replayHistory([]models.UserHistoryEntry{created, unknown}); err == nil {
+		t.Errorf("UnknownColumn: expected error")
+	}
+}
+
+func newMockRepo(t *testing.T) (*repo, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock: unexpected error %v", err)
+	}
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &repo{db: sqlx.NewDb(db, "postgres")}, mock
+}
+
+func TestGetUsers(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	columns := []string{"id", "calendar_id", "resume_id", "name", "surname", "patronymic", "email", "version", "deleted_at"}
+
+	mock.ExpectQuery(`SELECT id, calendar_id, resume_id, name, surname, patronymic, email, version, deleted_at FROM users ` +
+		`WHERE id = ANY\(\$1\) AND deleted_at IS NULL`).
+		WithArgs("{3,1}").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, 2, 3, "Иван", "Иванов", "", "ivan@example.com", 4, nil))
+
+	users, err := r.GetUsers(context.Background(), []uint64{3, 1})
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := []models.User{{Id: 1, CalendarId: 2, ResumeId: 3, Name: "Иван", Surname: "Иванов", Email: "ivan@example.com", Version: 4}}
+	if !reflect.DeepEqual(users, expected) {
+		t.Errorf("expected %v, but got %v", expected, users)
+	}
+
+	if users, err := r.GetUsers(context.Background(), nil); err != nil || len(users) != 0 {
+		t.Errorf("Empty: expected no users without query, but got %v, %v", users, err)
+	}
+
+	mock.ExpectQuery("SELECT").WillReturnError(&pq.Error{Code: "08006"})
+
+	if _, err := r.GetUsers(context.Background(), []uint64{1}); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("ConnectionFailure: expected %v, but got %v", ErrUnavailable, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSearchQueryFilter(t *testing.T) {
+	const selectUsers = "SELECT id, calendar_id, resume_id, name, surname, patronymic, email, version, deleted_at FROM users "
+
+	cases := []struct {
+		name   string
+		filter models.UserSearchFilter
+		where  string
+		args   []interface{}
+	}{
+		{"Empty", models.UserSearchFilter{}, "WHERE (deleted_at IS NULL)", nil},
+		{
+			"Prefixes",
+			models.UserSearchFilter{Name: "Ив", Surname: "ПЕТ", Patronymic: "сер"},
+			"WHERE (lower(name) LIKE $1 AND lower(surname) LIKE $2 AND lower(patronymic) LIKE $3 AND deleted_at IS NULL)",
+			[]interface{}{"ив%", "пет%", "сер%"},
+		},
+		{
+			"EscapedPrefix",
+			models.UserSearchFilter{Name: `a_b%c\`},
+			"WHERE (lower(name) LIKE $1 AND deleted_at IS NULL)",
+			[]interface{}{`a\_b\%c\\%`},
+		},
+		{
+			"ExactEmail",
+			models.UserSearchFilter{Email: " User@Example.COM "},
+			"WHERE (email = $1 AND deleted_at IS NULL)",
+			[]interface{}{"user@example.com"},
+		},
+		{
+			"Ids",
+			models.UserSearchFilter{CalendarId: 2, ResumeId: 3},
+			"WHERE (calendar_id = $1 AND resume_id = $2 AND deleted_at IS NULL)",
+			[]interface{}{uint64(2), uint64(3)},
+		},
+		{"IncludeDeleted", models.UserSearchFilter{IncludeDeleted: true}, "WHERE (1=1)", nil},
+	}
+
+	for _, item := range cases {
+		query, err := searchQuery(models.UserSearchParams{Filter: item.filter})
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", item.name, err)
+		}
+
+		sql, args, err := query.ToSql()
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", item.name, err)
+		}
+
+		if expected := selectUsers + item.where + " ORDER BY id ASC"; sql != expected {
+			t.Errorf("%s: expected query %q, but got %q", item.name, expected, sql)
+		}
+
+		if !reflect.DeepEqual(args, item.args) {
+			t.Errorf("%s: expected args %#v, but got %#v", item.name, item.args, args)
+		}
+	}
+}
+
+func TestSearchQuerySort(t *testing.T) {
+	const selectUsers = "SELECT id, calendar_id, resume_id, name, surname, patronymic, email, version, deleted_at FROM users " +
+		"WHERE (deleted_at IS NULL)"
+
+	cases := []struct {
+		field  models.UserSortField
+		column string
+		value  string
+		arg    interface{}
+	}{
+		{models.SortById, "id", "", nil},
+		{models.SortByName, "name", "Иван", "Иван"},
+		{models.SortBySurname, "surname", "Иванов", "Иванов"},
+		{models.SortByPatronymic, "patronymic", "Иванович", "Иванович"},
+		{models.SortByEmail, "email", "ivan@example.com", "ivan@example.com"},
+		{models.SortByCalendarId, "calendar_id", "7", uint64(7)},
+		{models.SortByResumeId, "resume_id", "8", uint64(8)},
+	}
+
+	for _, item := range cases {
+		for _, desc := range []bool{false, true} {
+			direction, compare := "ASC", ">"
+			if desc {
+				direction, compare = "DESC", "<"
+			}
+
+			name := fmt.Sprintf("%s %s", item.column, direction)
+
+			order := " ORDER BY " + item.column + " " + direction + ", id " + direction
+			condition := fmt.Sprintf(" AND (%s, id) %s ($1, $2)", item.column, compare)
+			args := []interface{}{item.arg, uint64(5)}
+
+			if item.column == "id" {
+				order = " ORDER BY id " + direction
+				condition = fmt.Sprintf(" AND id %s $1", compare)
+				args = []interface{}{uint64(5)}
+			}
+
+			params := models.UserSearchParams{SortField: item.field, SortDesc: desc}
+
+			for _, after := range []*models.UserCursor{nil, {Id: 5, Value: item.value}} {
+				params.After = after
+
+				query, err := searchQuery(params)
+				if err != nil {
+					t.Fatalf("%s: unexpected error %v", name, err)
+				}
+
+				sql, actualArgs, err := query.ToSql()
+				if err != nil {
+					t.Fatalf("%s: unexpected error %v", name, err)
+				}
+
+				expected, expectedArgs := selectUsers+order, []interface{}(nil)
+				if after != nil {
+					expected, expectedArgs = selectUsers+condition+order, args
+				}
+
+				if sql != expected {
+					t.Errorf("%s: expected query %q, but got %q", name, expected, sql)
+				}
+
+				if !reflect.DeepEqual(actualArgs, expectedArgs) {
+					t.Errorf("%s: expected args %#v, but got %#v", name, expectedArgs, actualArgs)
+				}
+			}
+		}
+	}
+
+	if _, err := searchQuery(models.UserSearchParams{SortField: models.UserSortField(100)}); err == nil {
+		t.Errorf("UnknownField: expected error")
+	}
+
+	if _, err := searchQuery(models.UserSearchParams{SortField: models.SortByCalendarId, After: &models.UserCursor{Value: "x"}}); err == nil {
+		t.Errorf("InvalidCursor: expected error")
+	}
+}
+
+// Строки пользователей в порядке userColumns.
+func mockUserRows(users ...models.User) *sqlmock.Rows {
+	rows := sqlmock.NewRows(userColumns)
+	for _, user := range users {
+		rows.AddRow(user.Id, user.CalendarId, user.ResumeId, user.Name, user.Surname, user.Patronymic, user.Email, user.Version, user.DeletedAt)
+	}
+
+	return rows
+}
+
+const (
+	lockUsersQuery = `SELECT id, calendar_id, resume_id, name, surname, patronymic, email, version, deleted_at FROM users ` +
+		`WHERE id = ANY\(\$1\) ORDER BY id FOR UPDATE`
+	returningUsers = `RETURNING id, calendar_id, resume_id, name, surname, patronymic, email, version, deleted_at`
+	insertHistory  = `INSERT INTO user_history \(user_id,operation,actor,trace_id,version,changes,created_at\) `
+	insertOutbox   = `INSERT INTO user_events_outbox \(event_type,payload\) `
+)
+
+func TestUpdateUser(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	ctx := audit.WithActor(context.Background(), "admin")
+
+	before := models.User{Id: 1, CalendarId: 2, ResumeId: 3, Name: "Иван", Surname: "Иванов", Email: "ivan@example.com", Version: 4}
+	after := before
+	after.Name = "Петр"
+	after.Email = "petr@example.com"
+	after.Version = 5
+
+	// Изменяются только поля из маски, адрес почты нормализуется, версия увеличивается.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows(before))
+	mock.ExpectQuery(`UPDATE users SET email = \$1, name = \$2, version = version \+ 1 `+
+		`WHERE deleted_at IS NULL AND id = \$3 AND version = \$4 `+returningUsers).
+		WithArgs("petr@example.com", "Петр", 1, 4).
+		WillReturnRows(mockUserRows(after))
+	mock.ExpectExec(insertHistory+`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,clock_timestamp\(\)\)`).
+		WithArgs(1, "update", "admin", "", 5, `{"email":{"before":"ivan@example.com","after":"petr@example.com"},"name":{"before":"Иван","after":"Петр"}}`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertOutbox+`VALUES \(\$1,\$2\)`).
+		WithArgs(producer.Updated, []byte(`{"Id":1}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	user := models.User{Id: 1, Name: "Петр", Surname: "Сидоров", Email: " Petr@Example.COM ", Version: 4}
+
+	updated, err := r.UpdateUser(ctx, &user, []models.UserField{models.UserFieldName, models.UserFieldEmail})
+	if err != nil || !updated {
+		t.Fatalf("expected user to be updated, but got %v, %v", updated, err)
+	}
+
+	if user.Version != 5 {
+		t.Errorf("expected version 5, but got %d", user.Version)
+	}
+
+	// Без ожидаемой версии условие на версию не добавляется, и отсутствие пользователя не является ошибкой.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows())
+	mock.ExpectQuery(`UPDATE users SET surname = \$1, version = version \+ 1 WHERE deleted_at IS NULL AND id = \$2 `+returningUsers).
+		WithArgs("Сидоров", 1).
+		WillReturnRows(mockUserRows())
+	mock.ExpectCommit()
+
+	user.Version = 0
+
+	if updated, err := r.UpdateUser(ctx, &user, []models.UserField{models.UserFieldSurname}); err != nil || updated {
+		t.Errorf("NotFound: expected no update, but got %v, %v", updated, err)
+	}
+
+	if _, err := r.UpdateUser(ctx, &user, nil); err == nil {
+		t.Errorf("NoFields: expected error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemoveUser(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	ctx := context.Background()
+
+	deletedAt := time.Date(2021, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	user := models.User{Id: 1, Name: "Иван", Version: 4}
+	removed := user
+	removed.DeletedAt = &deletedAt
+	removed.Version = 5
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows(user))
+	mock.ExpectQuery(`UPDATE users SET deleted_at = clock_timestamp\(\), version = version \+ 1 `+
+		`WHERE deleted_at IS NULL AND id = \$1 AND version = \$2 `+returningUsers).
+		WithArgs(1, 4).
+		WillReturnRows(mockUserRows(removed))
+	mock.ExpectExec(insertHistory+`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,clock_timestamp\(\)\)`).
+		WithArgs(1, "remove", "", "", 5, `{"deleted_at":{"before":null,"after":"2021-06-01T09:30:00Z"}}`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertOutbox+`VALUES \(\$1,\$2\)`).
+		WithArgs(producer.Removed, []byte(`{"Id":1}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if isDeleted, err := r.RemoveUser(ctx, 1, 4); err != nil || !isDeleted {
+		t.Errorf("expected user to be removed, but got %v, %v", isDeleted, err)
+	}
+
+	// Пользователь существует, но его версия изменилась: транзакция откатывается с ErrVersionMismatch.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows(removed))
+	mock.ExpectQuery(`UPDATE users SET deleted_at = clock_timestamp\(\)`).WithArgs(1, 4).WillReturnRows(mockUserRows())
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT 1 FROM users WHERE id = \$1 AND deleted_at IS NULL\)`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	if isDeleted, err := r.RemoveUser(ctx, 1, 4); err != ErrVersionMismatch || isDeleted {
+		t.Errorf("VersionMismatch: expected %v, but got %v, %v", ErrVersionMismatch, isDeleted, err)
+	}
+
+	// Пользователь уже удален: ошибки нет, событие не публикуется.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows(removed))
+	mock.ExpectQuery(`UPDATE users SET deleted_at = clock_timestamp\(\)`).WithArgs(1, 4).WillReturnRows(mockUserRows())
+	mock.ExpectQuery(`SELECT EXISTS`).WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectCommit()
+
+	if isDeleted, err := r.RemoveUser(ctx, 1, 4); err != nil || isDeleted {
+		t.Errorf("AlreadyRemoved: expected no removal, but got %v, %v", isDeleted, err)
+	}
+
+	// Без ожидаемой версии причина отсутствия изменений не проверяется.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows())
+	mock.ExpectQuery(`UPDATE users SET deleted_at = clock_timestamp\(\), version = version \+ 1 ` +
+		`WHERE deleted_at IS NULL AND id = \$1 ` + returningUsers).
+		WithArgs(1).
+		WillReturnRows(mockUserRows())
+	mock.ExpectCommit()
+
+	if isDeleted, err := r.RemoveUser(ctx, 1, 0); err != nil || isDeleted {
+		t.Errorf("NotFound: expected no removal, but got %v, %v", isDeleted, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRestoreUser(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	ctx := context.Background()
+
+	deletedAt := time.Date(2021, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	removed := models.User{Id: 1, Name: "Иван", Version: 5, DeletedAt: &deletedAt}
+	restored := removed
+	restored.DeletedAt = nil
+	restored.Version = 6
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows(removed))
+	mock.ExpectQuery(`UPDATE users SET deleted_at = \$1, version = version \+ 1 `+
+		`WHERE \(id = \$2 AND deleted_at IS NOT NULL\) `+returningUsers).
+		WithArgs(nil, 1).
+		WillReturnRows(mockUserRows(restored))
+	mock.ExpectExec(insertHistory+`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,clock_timestamp\(\)\)`).
+		WithArgs(1, "restore", "", "", 6, `{"deleted_at":{"before":"2021-06-01T09:30:00Z","after":null}}`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertOutbox+`VALUES \(\$1,\$2\)`).
+		WithArgs(producer.Restored, []byte(`{"Id":1}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	if isRestored, err := r.RestoreUser(ctx, 1); err != nil || !isRestored {
+		t.Errorf("expected user to be restored, but got %v, %v", isRestored, err)
+	}
+
+	// Пользователь не удален: история и событие не пишутся.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1}").WillReturnRows(mockUserRows(restored))
+	mock.ExpectQuery(`UPDATE users SET deleted_at = \$1`).WithArgs(nil, 1).WillReturnRows(mockUserRows())
+	mock.ExpectCommit()
+
+	if isRestored, err := r.RestoreUser(ctx, 1); err != nil || isRestored {
+		t.Errorf("NotRemoved: expected no restoration, but got %v, %v", isRestored, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPurgeDeletedUsers(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	ctx := context.Background()
+
+	deletedBefore := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	// Для каждого окончательно удаленного пользователя публикуется событие.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM users WHERE deleted_at < \$1 RETURNING "id"`).
+		WithArgs(deletedBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3))
+	mock.ExpectExec(insertOutbox+`VALUES \(\$1,\$2\),\(\$3,\$4\)`).
+		WithArgs(producer.Purged, []byte(`{"Id":1}`), producer.Purged, []byte(`{"Id":3}`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	if purged, err := r.PurgeDeletedUsers(ctx, deletedBefore); err != nil || purged != 2 {
+		t.Errorf("expected 2 purged users, but got %d, %v", purged, err)
+	}
+
+	// Без удаленных пользователей события не публикуются.
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM users`).WithArgs(deletedBefore).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	if purged, err := r.PurgeDeletedUsers(ctx, deletedBefore); err != nil || purged != 0 {
+		t.Errorf("Empty: expected no purged users, but got %d, %v", purged, err)
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`DELETE FROM users`).WillReturnError(&pq.Error{Code: "08006"})
+	mock.ExpectRollback()
+
+	if _, err := r.PurgeDeletedUsers(ctx, deletedBefore); !errors.Is(err, ErrUnavailable) {
+		t.Errorf("ConnectionFailure: expected %v, but got %v", ErrUnavailable, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateUsers(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	ctx := context.Background()
+
+	updates := []models.UserUpdate{
+		{User: models.User{Id: 1, Name: "Петр", Version: 4}, Fields: []models.UserField{models.UserFieldName}},
+		{User: models.User{Id: 2, Email: " Ann@Example.COM ", Version: 7}, Fields: []models.UserField{models.UserFieldEmail}},
+		{User: models.User{Id: 3, CalendarId: 9}, Fields: []models.UserField{models.UserFieldCalendarId}},
+	}
+
+	first := models.User{Id: 1, Name: "Иван", Version: 4}
+	updated := first
+	updated.Name = "Петр"
+	updated.Version = 5
+
+	// Для каждого поля передаются признак изменения и значение с приведением к типу столбца,
+	// столбец изменяется, только если признак установлен.
+	query := regexp.QuoteMeta("UPDATE users AS u SET " +
+		"calendar_id = CASE WHEN v.set_calendar_id THEN v.calendar_id ELSE u.calendar_id END, " +
+		"resume_id = CASE WHEN v.set_resume_id THEN v.resume_id ELSE u.resume_id END, " +
+		"name = CASE WHEN v.set_name THEN v.name ELSE u.name END, " +
+		"surname = CASE WHEN v.set_surname THEN v.surname ELSE u.surname END, " +
+		"patronymic = CASE WHEN v.set_patronymic THEN v.patronymic ELSE u.patronymic END, " +
+		"email = CASE WHEN v.set_email THEN v.email ELSE u.email END, " +
+		"version = u.version + 1 " +
+		"FROM (VALUES " +
+		"($1::bigint, $2::bigint, $3::boolean, $4::bigint, $5::boolean, $6::bigint, $7::boolean, $8::text, " +
+		"$9::boolean, $10::text, $11::boolean, $12::text, $13::boolean, $14::text), " +
+		"($15::bigint, $16::bigint, $17::boolean, $18::bigint, $19::boolean, $20::bigint, $21::boolean, $22::text, " +
+		"$23::boolean, $24::text, $25::boolean, $26::text, $27::boolean, $28::text)) " +
+		"AS v(id, expected_version, set_calendar_id, calendar_id, set_resume_id, resume_id, set_name, name, " +
+		"set_surname, surname, set_patronymic, patronymic, set_email, email) " +
+		"WHERE u.id = v.id AND u.deleted_at IS NULL AND (v.expected_version = 0 OR u.version = v.expected_version) " +
+		"RETURNING u.id, u.calendar_id, u.resume_id, u.name, u.surname, u.patronymic, u.email, u.version, u.deleted_at")
+
+	mock.ExpectBegin()
+
+	// Первая пачка: первый пользователь изменен, версия второго отличается от ожидаемой.
+	mock.ExpectQuery(lockUsersQuery).
+		WithArgs("{1,2}").
+		WillReturnRows(mockUserRows(first, models.User{Id: 2, Email: "ann@example.com", Version: 8}))
+	mock.ExpectQuery(query).
+		WithArgs(
+			1, 4, false, 0, false, 0, true, "Петр", false, "", false, "", false, "",
+			2, 7, false, 0, false, 0, false, "", false, "", false, "", true, "ann@example.com",
+		).
+		WillReturnRows(mockUserRows(updated))
+	mock.ExpectExec(insertHistory+`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,clock_timestamp\(\)\)`).
+		WithArgs(1, "update", "", "", 5, `{"name":{"before":"Иван","after":"Петр"}}`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertOutbox+`VALUES \(\$1,\$2\)`).
+		WithArgs(producer.Updated, []byte(`{"Id":1}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	// Вторая пачка: пользователь не найден, история и события не пишутся.
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{3}").WillReturnRows(mockUserRows())
+	mock.ExpectQuery(`UPDATE users AS u SET .* FROM \(VALUES \(\$1::bigint, \$2::bigint, .*, \$14::text\)\) AS v`).
+		WithArgs(3, 0, true, 9, false, 0, false, "", false, "", false, "", false, "").
+		WillReturnRows(mockUserRows())
+
+	mock.ExpectCommit()
+
+	results, err := r.UpdateUsers(ctx, updates, 2)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := []ChangeResult{
+		{Id: 1, Version: 5},
+		{Id: 2, Err: ErrVersionMismatch},
+		{Id: 3, Err: ErrNotFound},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected %v, but got %v", expected, results)
+	}
+
+	// Ошибка пачки откатывает все изменения.
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).WithArgs("{1,2}").WillReturnError(&pq.Error{Code: "08006"})
+	mock.ExpectRollback()
+
+	if results, err := r.UpdateUsers(ctx, updates, 2); !errors.Is(err, ErrUnavailable) || results != nil {
+		t.Errorf("ConnectionFailure: expected %v, but got %v, %v", ErrUnavailable, results, err)
+	}
+
+	if _, err := r.UpdateUsers(ctx, updates, 0); err == nil {
+		t.Errorf("InvalidChunkSize: expected error")
+	}
+
+	if _, err := r.UpdateUsers(ctx, []models.UserUpdate{{User: models.User{Id: 1}}}, 2); err == nil {
+		t.Errorf("NoFields: expected error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemoveUsers(t *testing.T) {
+	r, mock := newMockRepo(t)
+
+	ctx := context.Background()
+
+	deletedAt := time.Date(2021, 6, 1, 9, 30, 0, 0, time.UTC)
+
+	first := models.User{Id: 1, Name: "Иван", Version: 4}
+	removed := first
+	removed.DeletedAt = &deletedAt
+	removed.Version = 5
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(lockUsersQuery).
+		WithArgs("{3,1,2}").
+		WillReturnRows(mockUserRows(first, models.User{Id: 2, Version: 3, DeletedAt: &deletedAt}))
+	mock.ExpectQuery(`UPDATE users SET deleted_at = clock_timestamp\(\), version = version \+ 1 ` +
+		`WHERE id = ANY\(\$1\) AND deleted_at IS NULL ` + returningUsers).
+		WithArgs("{3,1,2}").
+		WillReturnRows(mockUserRows(removed))
+	mock.ExpectExec(insertHistory+`VALUES \(\$1,\$2,\$3,\$4,\$5,\$6,clock_timestamp\(\)\)`).
+		WithArgs(1, "remove", "", "", 5, `{"deleted_at":{"before":null,"after":"2021-06-01T09:30:00Z"}}`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(insertOutbox+`VALUES \(\$1,\$2\)`).
+		WithArgs(producer.Removed, []byte(`{"Id":1}`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	results, err := r.RemoveUsers(ctx, []uint64{3, 1, 2}, 3)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Результаты возвращаются в порядке запроса, уже удаленный пользователь не найден.
+	expected := []ChangeResult{
+		{Id: 3, Err: ErrNotFound},
+		{Id: 1, Version: 5},
+		{Id: 2, Err: ErrNotFound},
+	}
+
+	if !reflect.DeepEqual(results, expected) {
+		t.Errorf("expected %v, but got %v", expected, results)
+	}
+
+	if _, err := r.RemoveUsers(ctx, []uint64{1}, 0); err == nil {
+		t.Errorf("InvalidChunkSize: expected error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// Соединение sqlmock, запоминающее параметры начатой транзакции: sqlmock их не проверяет.
+type txOptionsConn struct {
+	mockConn
+	options *driver.TxOptions
+}
+
+type mockConn interface {
+	driver.Conn
+	driver.ConnBeginTx
+	driver.ExecerContext
+	driver.QueryerContext
+	driver.NamedValueChecker
+}
+
+func (c *txOptionsConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	*c.options = opts
+	return c.mockConn.BeginTx(ctx, opts)
+}
+
+type txOptionsConnector struct {
+	conn *txOptionsConn
+}
+
+func (c *txOptionsConnector) Connect(context.Context) (driver.Conn, error) {
+	return c.conn, nil
+}
+
+func (c *txOptionsConnector) Driver() driver.Driver {
+	return nil
+}
+
+func TestExportUsers(t *testing.T) {
+	mockDB, mock, err := sqlmock.NewWithDSN("export")
+	if err != nil {
+		t.Fatalf("sqlmock: unexpected error %v", err)
+	}
+
+	conn, err := mockDB.Driver().Open("export")
+	if err != nil {
+		t.Fatalf("sqlmock: unexpected error %v", err)
+	}
+
+	var options driver.TxOptions
+
+	db := sql.OpenDB(&txOptionsConnector{conn: &txOptionsConn{mockConn: conn.(mockConn), options: &options}})
+
+	t.Cleanup(func() {
+		_ = db.Close()
+		_ = mockDB.Close()
+	})
+
+	r := &repo{db: sqlx.NewDb(db, "postgres")}
+
+	params := models.UserSearchParams{Filter: models.UserSearchFilter{Email: "ivan@example.com"}}
+
+	first := models.User{Id: 1, Name: "Иван", Email: "ivan@example.com", Version: 1}
+	second := models.User{Id: 2, Name: "Петр", Email: "ivan@example.com", Version: 3}
+
+	// Курсор объявляется с параметрами фильтра, пачки читаются, пока FETCH возвращает полную пачку.
+	mock.ExpectBegin()
+	mock.ExpectExec(`DECLARE users_export NO SCROLL CURSOR FOR ` +
+		`SELECT id, calendar_id, resume_id, name, surname, patronymic, email, version, deleted_at FROM users ` +
+		`WHERE \(email = \$1 AND deleted_at IS NULL\) ORDER BY id ASC`).
+		WithArgs("ivan@example.com").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`FETCH FORWARD 2 FROM users_export`).WillReturnRows(mockUserRows(first, second))
+	mock.ExpectQuery(`FETCH FORWARD 2 FROM users_export`).WillReturnRows(mockUserRows())
+	mock.ExpectCommit()
+
+	var batches [][]models.User
+
+	err = r.ExportUsers(context.Background(), params, 2, func(users []models.User) error {
+		batches = append(batches, users)
+		return nil
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	// Пустая пачка не передается в fn.
+	if expected := [][]models.User{{first, second}}; !reflect.DeepEqual(batches, expected) {
+		t.Errorf("expected %v, but got %v", expected, batches)
+	}
+
+	if expected := (driver.TxOptions{Isolation: driver.IsolationLevel(sql.LevelRepeatableRead), ReadOnly: true}); options != expected {
+		t.Errorf("expected transaction options %+v, but got %+v", expected, options)
+	}
+
+	// Ошибка обработки пачки прерывает выгрузку и откатывает транзакцию.
+	fnErr := errors.New("client is gone")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DECLARE users_export`).WithArgs("ivan@example.com").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`FETCH FORWARD 2 FROM users_export`).WillReturnRows(mockUserRows(first, second))
+	mock.ExpectRollback()
+
+	err = r.ExportUsers(context.Background(), params, 2, func([]models.User) error {
+		return fnErr
+	})
+
+	if err != fnErr {
+		t.Errorf("expected %v, but got %v", fnErr, err)
+	}
+
+	if err := r.ExportUsers(context.Background(), params, 0, nil); err == nil {
+		t.Errorf("InvalidBatchSize: expected error")
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
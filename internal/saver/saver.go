@@ -2,16 +2,46 @@ package saver
 
 import (
 	"context"
+	"errors"
 	"sync"
 
 	"github.com/ozoncp/ocp-user-api/internal/alarm"
 	"github.com/ozoncp/ocp-user-api/internal/flusher"
+	"github.com/ozoncp/ocp-user-api/internal/metrics"
 	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/repo"
 )
 
+// Пользователь вытеснен из заполненного буфера и не будет сохранен.
+var ErrDropped = errors.New("user was dropped from the full saver buffer")
+
+// Пользователь передан в закрытый буфер и не будет сохранен.
+var ErrClosed = errors.New("user was passed to the closed saver buffer")
+
+// Функция уведомления о результате сохранения пользователя: nil, если пользователь создан,
+// ошибка сохранения, ErrDropped или ErrClosed.
+type DoneFunc func(err error)
+
+// Сохранитель пользователей. Каждый поток пользователей открывает собственный буфер,
+// поэтому сброс буфера сохраняет только пользователей этого потока. По сигналу таймера
+// сбрасываются все открытые буферы.
 type Saver interface {
 	Init(ctx context.Context)
-	Save(ctx context.Context, user models.User)
+	// Открытие буфера потока. Пользователи буфера сохраняются с контекстом ctx,
+	// из которого берутся инициатор и трасса изменений.
+	Open(ctx context.Context) Buffer
+	// Закрытие сохранителя. Открытые буферы сбрасываются и закрываются.
+	Close()
+}
+
+// Буфер пользователей одного потока.
+type Buffer interface {
+	// Добавление пользователя в буфер. Функция done, если задана, вызывается один раз
+	// с результатом сохранения пользователя.
+	Save(user models.User, done DoneFunc)
+	// Сброс буфера в БД, не дожидаясь сигнала таймера.
+	Flush()
+	// Сброс оставшихся пользователей без повторов и закрытие буфера.
 	Close()
 }
 
@@ -21,62 +51,92 @@ func NewSaver(
 	flusher flusher.Flusher,
 ) Saver {
 	return &saver{
-		buffer: usersBuffer{
-			users: make([]models.User, 0, capacity),
-		},
 		capacity: capacity,
+		buffers:  make(map[*buffer]struct{}),
 		done:     make(chan struct{}),
+		close:    make(chan struct{}),
 		alarm:    alarm,
 		flusher:  flusher,
 	}
 }
 
-type usersBuffer struct {
-	sync.Mutex
-	users []models.User
+type item struct {
+	user models.User
+	done DoneFunc
 }
 
-// Реализация интерфейса Saver на основе slice. Новые элементы добавляются в конец буфера.
-// При заполнении буфера, удаляется первый элемент.
+// Результат сохранения пользователя, о котором уведомляется функция done после освобождения буфера.
+type result struct {
+	done DoneFunc
+	err  error
+}
+
+// Реализация интерфейса Saver. Открытые буферы сбрасываются по сигналу таймера и при закрытии сохранителя.
 type saver struct {
-	buffer   usersBuffer
 	capacity int
 	done     chan struct{}
 	close    chan struct{}
 	alarm    alarm.Alarm
 	flusher  flusher.Flusher
+
+	mutex   sync.Mutex
+	buffers map[*buffer]struct{}
+	closed  bool
 }
 
 func (s *saver) Init(ctx context.Context) {
 	go s.run(ctx)
 }
 
-func (s *saver) Save(ctx context.Context, user models.User) {
-	s.buffer.Lock()
-	defer s.buffer.Unlock()
+func (s *saver) Open(ctx context.Context) Buffer {
+	b := &buffer{
+		ctx:   ctx,
+		saver: s,
+		items: make([]item, 0, s.capacity),
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
-	if len(s.buffer.users) == s.capacity {
-		s.buffer.users = append(s.buffer.users[1:], user)
+	if s.closed {
+		b.closed = true
 	} else {
-		s.buffer.users = append(s.buffer.users, user)
+		s.buffers[b] = struct{}{}
 	}
+
+	return b
 }
 
-func (s *saver) flush(ctx context.Context) {
-	s.buffer.Lock()
-	defer s.buffer.Unlock()
+// Открытые буферы. Если closing установлен, сохранитель закрывается и новые буферы открываются закрытыми.
+func (s *saver) openBuffers(closing bool) []*buffer {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
 
-	processed := s.flusher.Flush(ctx, s.buffer.users)
+	buffers := make([]*buffer, 0, len(s.buffers))
+	for b := range s.buffers {
+		buffers = append(buffers, b)
+	}
 
-	if processed != len(s.buffer.users) {
-		s.buffer.users = s.buffer.users[processed:]
-	} else {
-		s.buffer.users = make([]models.User, 0, s.capacity)
+	if closing {
+		s.closed = true
+		s.buffers = make(map[*buffer]struct{})
 	}
+
+	return buffers
 }
 
-func (s *saver) dispose(ctx context.Context) {
-	s.flush(ctx)
+func (s *saver) remove(b *buffer) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.buffers, b)
+}
+
+func (s *saver) dispose() {
+	for _, b := range s.openBuffers(true) {
+		notify(b.dispose())
+	}
+
 	s.done <- struct{}{}
 }
 
@@ -86,14 +146,16 @@ func (s *saver) run(ctx context.Context) {
 	for {
 		select {
 		case <-flushSignal:
-			s.flush(ctx)
+			for _, b := range s.openBuffers(false) {
+				b.Flush()
+			}
 
 		case <-s.close:
-			s.dispose(ctx)
+			s.dispose()
 			return
 
 		case <-ctx.Done():
-			s.dispose(ctx)
+			s.dispose()
 			return
 		}
 	}
@@ -103,3 +165,114 @@ func (s *saver) Close() {
 	s.close <- struct{}{}
 	<-s.done
 }
+
+// Реализация интерфейса Buffer на основе slice. Новые элементы добавляются в конец буфера.
+// При заполнении буфера, удаляется первый элемент.
+// Пользователи, не сохраненные из-за недоступности БД, остаются в буфере до следующего сброса,
+// остальные ошибки сохранения передаются в done.
+type buffer struct {
+	sync.Mutex
+	ctx    context.Context
+	saver  *saver
+	items  []item
+	closed bool
+}
+
+func (b *buffer) Save(user models.User, done DoneFunc) {
+	var results []result
+
+	b.Lock()
+
+	switch {
+	case b.closed:
+		results = append(results, result{done: done, err: ErrClosed})
+	case len(b.items) == b.saver.capacity:
+		results = append(results, result{done: b.items[0].done, err: ErrDropped})
+		b.items = append(b.items[1:], item{user: user, done: done})
+		metrics.IncSaverDropped()
+	default:
+		b.items = append(b.items, item{user: user, done: done})
+		metrics.AddSaverBufferSize(1)
+	}
+
+	b.Unlock()
+
+	notify(results)
+}
+
+func (b *buffer) Flush() {
+	b.Lock()
+	results := b.flush(true)
+	b.Unlock()
+
+	notify(results)
+}
+
+func (b *buffer) Close() {
+	b.saver.remove(b)
+	notify(b.dispose())
+}
+
+// Сброс буфера без повторов и его закрытие.
+func (b *buffer) dispose() []result {
+	b.Lock()
+	defer b.Unlock()
+
+	b.closed = true
+
+	return b.flush(false)
+}
+
+// Сброс буфера в БД, вызывается при заблокированном буфере. Если retry установлен,
+// пользователи с временными ошибками сохранения остаются в буфере.
+// Возвращает результаты сохранения остальных пользователей.
+func (b *buffer) flush(retry bool) []result {
+	if len(b.items) == 0 {
+		return nil
+	}
+
+	users := make([]models.User, 0, len(b.items))
+	for _, item := range b.items {
+		users = append(users, item.user)
+	}
+
+	failures := b.saver.flusher.Flush(b.ctx, users)
+
+	errs := make(map[int]error, len(failures))
+	for _, failure := range failures {
+		errs[failure.Index] = failure.Err
+	}
+
+	results := make([]result, 0, len(b.items))
+	retained := make([]item, 0, b.saver.capacity)
+
+	for index, item := range b.items {
+		err := errs[index]
+
+		if err != nil && retry && isTemporary(err) {
+			retained = append(retained, item)
+			continue
+		}
+
+		results = append(results, result{done: item.done, err: err})
+	}
+
+	metrics.AddSaverBufferSize(len(retained) - len(b.items))
+
+	b.items = retained
+
+	return results
+}
+
+// Уведомления вызываются без блокировки буфера, чтобы функции done могли обращаться к буферу.
+func notify(results []result) {
+	for _, result := range results {
+		if result.done != nil {
+			result.done(result.err)
+		}
+	}
+}
+
+func isTemporary(err error) bool {
+	return errors.Is(err, repo.ErrUnavailable) || errors.Is(err, repo.ErrTimeout)
+}
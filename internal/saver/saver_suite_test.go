@@ -0,0 +1,13 @@
+package saver_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSaver(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Saver Suite")
+}
@@ -0,0 +1,188 @@
+package saver_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/ozoncp/ocp-user-api/internal/audit"
+	"github.com/ozoncp/ocp-user-api/internal/flusher"
+	"github.com/ozoncp/ocp-user-api/internal/mocks"
+	"github.com/ozoncp/ocp-user-api/internal/models"
+	"github.com/ozoncp/ocp-user-api/internal/repo"
+	"github.com/ozoncp/ocp-user-api/internal/saver"
+)
+
+var _ = Describe("Saver", func() {
+
+	var (
+		ctrl *gomock.Controller
+		ctx  context.Context
+
+		mockAlarm   *mocks.MockAlarm
+		mockFlusher *mocks.MockFlusher
+
+		alarms    chan struct{}
+		results   map[string]error
+		userSaver saver.Saver
+	)
+
+	done := func(name string) saver.DoneFunc {
+		return func(err error) {
+			results[name] = err
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockAlarm = mocks.NewMockAlarm(ctrl)
+		mockFlusher = mocks.NewMockFlusher(ctrl)
+
+		alarms = make(chan struct{})
+		results = make(map[string]error)
+
+		mockAlarm.EXPECT().Alarm().Return(alarms).AnyTimes()
+
+		userSaver = saver.NewSaver(2, mockAlarm, mockFlusher)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("notifies about saved and failed users", func() {
+		conflict := &repo.ConflictError{Field: "email"}
+
+		mockFlusher.EXPECT().
+			Flush(ctx, []models.User{{Name: "a"}, {Name: "b"}}).
+			Return([]flusher.Failure{{Index: 1, Err: conflict}})
+
+		buffer := userSaver.Open(ctx)
+		buffer.Save(models.User{Name: "a"}, done("a"))
+		buffer.Save(models.User{Name: "b"}, done("b"))
+		buffer.Flush()
+
+		Expect(results).Should(HaveLen(2))
+		Expect(results["a"]).ShouldNot(HaveOccurred())
+		Expect(results["b"]).Should(Equal(conflict))
+	})
+
+	It("drops the oldest user from the full buffer", func() {
+		mockFlusher.EXPECT().Flush(ctx, []models.User{{Name: "b"}, {Name: "c"}}).Return(nil)
+
+		buffer := userSaver.Open(ctx)
+		buffer.Save(models.User{Name: "a"}, done("a"))
+		buffer.Save(models.User{Name: "b"}, done("b"))
+		buffer.Save(models.User{Name: "c"}, nil)
+
+		Expect(results).Should(Equal(map[string]error{"a": saver.ErrDropped}))
+
+		buffer.Flush()
+
+		Expect(results).Should(Equal(map[string]error{"a": saver.ErrDropped, "b": nil}))
+	})
+
+	It("retries users on temporary errors", func() {
+		unavailable := fmt.Errorf("%w: dial tcp", repo.ErrUnavailable)
+
+		gomock.InOrder(
+			mockFlusher.EXPECT().
+				Flush(ctx, []models.User{{Name: "a"}, {Name: "b"}}).
+				Return([]flusher.Failure{{Index: 0, Err: unavailable}, {Index: 1, Err: errors.New("db error")}}),
+			mockFlusher.EXPECT().Flush(ctx, []models.User{{Name: "a"}}).Return(nil),
+		)
+
+		buffer := userSaver.Open(ctx)
+		buffer.Save(models.User{Name: "a"}, done("a"))
+		buffer.Save(models.User{Name: "b"}, done("b"))
+		buffer.Flush()
+
+		Expect(results).Should(HaveLen(1))
+		Expect(results["b"]).Should(MatchError("db error"))
+
+		buffer.Flush()
+
+		Expect(results).Should(HaveKeyWithValue("a", BeNil()))
+	})
+
+	It("flushes only users of the buffer with its context", func() {
+		aliceCtx := audit.WithActor(ctx, "alice")
+		bobCtx := audit.WithActor(ctx, "bob")
+
+		gomock.InOrder(
+			mockFlusher.EXPECT().Flush(aliceCtx, []models.User{{Name: "a"}, {Name: "b"}}).Return(nil),
+			mockFlusher.EXPECT().Flush(bobCtx, []models.User{{Name: "c"}, {Name: "d"}}).Return(nil),
+		)
+
+		alice := userSaver.Open(aliceCtx)
+		bob := userSaver.Open(bobCtx)
+
+		// Буферы заполняются независимо, поэтому пользователи не вытесняются.
+		alice.Save(models.User{Name: "a"}, done("a"))
+		bob.Save(models.User{Name: "c"}, done("c"))
+		alice.Save(models.User{Name: "b"}, done("b"))
+		bob.Save(models.User{Name: "d"}, done("d"))
+
+		alice.Flush()
+
+		Expect(results).Should(Equal(map[string]error{"a": nil, "b": nil}))
+
+		bob.Flush()
+
+		Expect(results).Should(HaveLen(4))
+	})
+
+	It("flushes open buffers on alarm and reports remaining failures on close", func() {
+		unavailable := fmt.Errorf("%w: dial tcp", repo.ErrUnavailable)
+
+		aliceCtx := audit.WithActor(ctx, "alice")
+		bobCtx := audit.WithActor(ctx, "bob")
+
+		mockFlusher.EXPECT().Flush(aliceCtx, []models.User{{Name: "a"}}).
+			Return([]flusher.Failure{{Index: 0, Err: unavailable}}).
+			Times(2)
+		mockFlusher.EXPECT().Flush(bobCtx, []models.User{{Name: "b"}}).
+			Return([]flusher.Failure{{Index: 0, Err: unavailable}}).
+			Times(2)
+
+		userSaver.Init(ctx)
+
+		userSaver.Open(aliceCtx).Save(models.User{Name: "a"}, done("a"))
+		userSaver.Open(bobCtx).Save(models.User{Name: "b"}, done("b"))
+
+		alarms <- struct{}{}
+
+		userSaver.Close()
+
+		Expect(results["a"]).Should(MatchError(repo.ErrUnavailable))
+		Expect(results["b"]).Should(MatchError(repo.ErrUnavailable))
+	})
+
+	It("rejects users passed to the closed buffer", func() {
+		mockFlusher.EXPECT().Flush(ctx, []models.User{{Name: "a"}}).Return(nil)
+
+		userSaver.Init(ctx)
+
+		buffer := userSaver.Open(ctx)
+		buffer.Save(models.User{Name: "a"}, done("a"))
+		buffer.Close()
+
+		// Закрытый буфер не сбрасывается по таймеру.
+		alarms <- struct{}{}
+
+		buffer.Save(models.User{Name: "b"}, done("b"))
+
+		userSaver.Close()
+
+		userSaver.Open(ctx).Save(models.User{Name: "c"}, done("c"))
+
+		Expect(results).Should(Equal(map[string]error{"a": nil, "b": saver.ErrClosed, "c": saver.ErrClosed}))
+	})
+})
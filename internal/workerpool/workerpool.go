@@ -0,0 +1,61 @@
+package workerpool
+
+import (
+	"context"
+	"sync"
+)
+
+// Выполнение задач task(0) ... task(count-1) не более чем в parallelism горутинах.
+// Возвращает ошибки задач в порядке их индексов. После отмены ctx новые задачи не запускаются,
+// для них возвращается ctx.Err(); уже запущенные задачи выполняются до конца.
+func Run(ctx context.Context, parallelism int, count int, task func(index int) error) []error {
+	errs := make([]error, count)
+
+	if parallelism <= 0 {
+		parallelism = 1
+	}
+
+	if parallelism > count {
+		parallelism = count
+	}
+
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	wg.Add(parallelism)
+
+	for i := 0; i < parallelism; i++ {
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				errs[index] = task(index)
+			}
+		}()
+	}
+
+	next := 0
+
+schedule:
+	for ; next < count; next++ {
+		// Отмена проверяется отдельно, так как select выбирает готовую ветку случайно.
+		if ctx.Err() != nil {
+			break
+		}
+
+		select {
+		case indexes <- next:
+		case <-ctx.Done():
+			break schedule
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	for ; next < count; next++ {
+		errs[next] = ctx.Err()
+	}
+
+	return errs
+}
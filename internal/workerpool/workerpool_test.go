@@ -0,0 +1,106 @@
+package workerpool
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRunPreservesOrder(t *testing.T) {
+	failed := errors.New("failed")
+
+	errs := Run(context.Background(), 3, 7, func(index int) error {
+		// Поздние задачи завершаются раньше ранних.
+		time.Sleep(time.Duration(7-index) * time.Millisecond)
+
+		if index%2 == 1 {
+			return failed
+		}
+
+		return nil
+	})
+
+	if len(errs) != 7 {
+		t.Fatalf("expected 7 results, but got %d", len(errs))
+	}
+
+	for index, err := range errs {
+		expected := error(nil)
+		if index%2 == 1 {
+			expected = failed
+		}
+
+		if err != expected {
+			t.Errorf("task %d: expected %v, but got %v", index, expected, err)
+		}
+	}
+}
+
+func TestRunLimitsParallelism(t *testing.T) {
+	var running, maxRunning int32
+
+	Run(context.Background(), 2, 10, func(index int) error {
+		current := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxRunning)
+			if current <= max || atomic.CompareAndSwapInt32(&maxRunning, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(time.Millisecond)
+		return nil
+	})
+
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent tasks, but got %d", maxRunning)
+	}
+}
+
+func TestRunStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var mu sync.Mutex
+	started := make(map[int]bool)
+
+	errs := Run(ctx, 1, 5, func(index int) error {
+		mu.Lock()
+		started[index] = true
+		mu.Unlock()
+
+		if index == 1 {
+			cancel()
+		}
+
+		return nil
+	})
+
+	for index, err := range errs {
+		if started[index] {
+			if err != nil {
+				t.Errorf("task %d: expected no error, but got %v", index, err)
+			}
+
+			continue
+		}
+
+		if err != context.Canceled {
+			t.Errorf("task %d: expected %v, but got %v", index, context.Canceled, err)
+		}
+	}
+
+	if started[4] {
+		t.Errorf("expected tasks not to start after cancel")
+	}
+}
+
+func TestRunEmpty(t *testing.T) {
+	if errs := Run(context.Background(), 4, 0, func(int) error { return nil }); len(errs) != 0 {
+		t.Errorf("expected no results, but got %d", len(errs))
+	}
+}
@@ -9,8 +9,12 @@ package ocp_user_api
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -22,13 +26,220 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type UserError_Reason int32
+
+const (
+	UserError_INTERNAL  UserError_Reason = 0
+	UserError_NOT_FOUND UserError_Reason = 1
+)
+
+// Enum value maps for UserError_Reason.
+var (
+	UserError_Reason_name = map[int32]string{
+		0: "INTERNAL",
+		1: "NOT_FOUND",
+	}
+	UserError_Reason_value = map[string]int32{
+		"INTERNAL":  0,
+		"NOT_FOUND": 1,
+	}
+)
+
+func (x UserError_Reason) Enum() *UserError_Reason {
+	p := new(UserError_Reason)
+	*p = x
+	return p
+}
+
+func (x UserError_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserError_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_user_api_ocp_user_api_proto_enumTypes[0].Descriptor()
+}
+
+func (UserError_Reason) Type() protoreflect.EnumType {
+	return &file_api_ocp_user_api_ocp_user_api_proto_enumTypes[0]
+}
+
+func (x UserError_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserError_Reason.Descriptor instead.
+func (UserError_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{14, 0}
+}
+
+type UserHistoryEntry_Operation int32
+
+const (
+	UserHistoryEntry_CREATE  UserHistoryEntry_Operation = 0
+	UserHistoryEntry_UPDATE  UserHistoryEntry_Operation = 1
+	UserHistoryEntry_REMOVE  UserHistoryEntry_Operation = 2
+	UserHistoryEntry_RESTORE UserHistoryEntry_Operation = 3
+)
+
+// Enum value maps for UserHistoryEntry_Operation.
+var (
+	UserHistoryEntry_Operation_name = map[int32]string{
+		0: "CREATE",
+		1: "UPDATE",
+		2: "REMOVE",
+		3: "RESTORE",
+	}
+	UserHistoryEntry_Operation_value = map[string]int32{
+		"CREATE":  0,
+		"UPDATE":  1,
+		"REMOVE":  2,
+		"RESTORE": 3,
+	}
+)
+
+func (x UserHistoryEntry_Operation) Enum() *UserHistoryEntry_Operation {
+	p := new(UserHistoryEntry_Operation)
+	*p = x
+	return p
+}
+
+func (x UserHistoryEntry_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserHistoryEntry_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_user_api_ocp_user_api_proto_enumTypes[1].Descriptor()
+}
+
+func (UserHistoryEntry_Operation) Type() protoreflect.EnumType {
+	return &file_api_ocp_user_api_ocp_user_api_proto_enumTypes[1]
+}
+
+func (x UserHistoryEntry_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserHistoryEntry_Operation.Descriptor instead.
+func (UserHistoryEntry_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{32, 0}
+}
+
+type UserSort_Field int32
+
+const (
+	UserSort_ID          UserSort_Field = 0
+	UserSort_NAME        UserSort_Field = 1
+	UserSort_SURNAME     UserSort_Field = 2
+	UserSort_PATRONYMIC  UserSort_Field = 3
+	UserSort_EMAIL       UserSort_Field = 4
+	UserSort_CALENDAR_ID UserSort_Field = 5
+	UserSort_RESUME_ID   UserSort_Field = 6
+)
+
+// Enum value maps for UserSort_Field.
+var (
+	UserSort_Field_name = map[int32]string{
+		0: "ID",
+		1: "NAME",
+		2: "SURNAME",
+		3: "PATRONYMIC",
+		4: "EMAIL",
+		5: "CALENDAR_ID",
+		6: "RESUME_ID",
+	}
+	UserSort_Field_value = map[string]int32{
+		"ID":          0,
+		"NAME":        1,
+		"SURNAME":     2,
+		"PATRONYMIC":  3,
+		"EMAIL":       4,
+		"CALENDAR_ID": 5,
+		"RESUME_ID":   6,
+	}
+)
+
+func (x UserSort_Field) Enum() *UserSort_Field {
+	p := new(UserSort_Field)
+	*p = x
+	return p
+}
+
+func (x UserSort_Field) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSort_Field) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_user_api_ocp_user_api_proto_enumTypes[2].Descriptor()
+}
+
+func (UserSort_Field) Type() protoreflect.EnumType {
+	return &file_api_ocp_user_api_ocp_user_api_proto_enumTypes[2]
+}
+
+func (x UserSort_Field) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSort_Field.Descriptor instead.
+func (UserSort_Field) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{36, 0}
+}
+
+type UserSort_Direction int32
+
+const (
+	UserSort_ASC  UserSort_Direction = 0
+	UserSort_DESC UserSort_Direction = 1
+)
+
+// Enum value maps for UserSort_Direction.
+var (
+	UserSort_Direction_name = map[int32]string{
+		0: "ASC",
+		1: "DESC",
+	}
+	UserSort_Direction_value = map[string]int32{
+		"ASC":  0,
+		"DESC": 1,
+	}
+)
+
+func (x UserSort_Direction) Enum() *UserSort_Direction {
+	p := new(UserSort_Direction)
+	*p = x
+	return p
+}
+
+func (x UserSort_Direction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserSort_Direction) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ocp_user_api_ocp_user_api_proto_enumTypes[3].Descriptor()
+}
+
+func (UserSort_Direction) Type() protoreflect.EnumType {
+	return &file_api_ocp_user_api_ocp_user_api_proto_enumTypes[3]
+}
+
+func (x UserSort_Direction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserSort_Direction.Descriptor instead.
+func (UserSort_Direction) EnumDescriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{36, 1}
+}
+
 type ListUsersV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Limit  uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit     uint64      `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Filter    *UserFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort      *UserSort   `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	PageToken string      `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 }
 
 func (x *ListUsersV1Request) Reset() {
@@ -70,11 +281,25 @@ func (x *ListUsersV1Request) GetLimit() uint64 {
 	return 0
 }
 
-func (x *ListUsersV1Request) GetOffset() uint64 {
+func (x *ListUsersV1Request) GetFilter() *UserFilter {
 	if x != nil {
-		return x.Offset
+		return x.Filter
 	}
-	return 0
+	return nil
+}
+
+func (x *ListUsersV1Request) GetSort() *UserSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+func (x *ListUsersV1Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
 type ListUsersV1Response struct {
@@ -82,8 +307,8 @@ type ListUsersV1Response struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users      []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	NextOffset uint64  `protobuf:"varint,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+	Users         []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextPageToken string  `protobuf:"bytes,3,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListUsersV1Response) Reset() {
@@ -125,11 +350,11 @@ func (x *ListUsersV1Response) GetUsers() []*User {
 	return nil
 }
 
-func (x *ListUsersV1Response) GetNextOffset() uint64 {
+func (x *ListUsersV1Response) GetNextPageToken() string {
 	if x != nil {
-		return x.NextOffset
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
 type CreateUserV1Request struct {
@@ -140,6 +365,8 @@ type CreateUserV1Request struct {
 	CalendarId uint64       `protobuf:"varint,2,opt,name=calendarId,proto3" json:"calendarId,omitempty"`
 	ResumeId   uint64       `protobuf:"varint,3,opt,name=resumeId,proto3" json:"resumeId,omitempty"`
 	Profile    *UserProfile `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	// Ключ идемпотентности. Может быть передан также в заголовке idempotency-key.
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (x *CreateUserV1Request) Reset() {
@@ -195,6 +422,13 @@ func (x *CreateUserV1Request) GetProfile() *UserProfile {
 	return nil
 }
 
+func (x *CreateUserV1Request) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type CreateUserV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -248,6 +482,8 @@ type RemoveUserV1Request struct {
 	unknownFields protoimpl.UnknownFields
 
 	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// Ожидаемая версия пользователя. Ноль отключает проверку версии.
+	ExpectedVersion uint64 `protobuf:"varint,2,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
 }
 
 func (x *RemoveUserV1Request) Reset() {
@@ -289,6 +525,13 @@ func (x *RemoveUserV1Request) GetUserId() uint64 {
 	return 0
 }
 
+func (x *RemoveUserV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
 type RemoveUserV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -336,7 +579,7 @@ func (x *RemoveUserV1Response) GetDeleted() bool {
 	return false
 }
 
-type DescribeUserV1Request struct {
+type RestoreUserV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -344,8 +587,8 @@ type DescribeUserV1Request struct {
 	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
 }
 
-func (x *DescribeUserV1Request) Reset() {
-	*x = DescribeUserV1Request{}
+func (x *RestoreUserV1Request) Reset() {
+	*x = RestoreUserV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,13 +596,13 @@ func (x *DescribeUserV1Request) Reset() {
 	}
 }
 
-func (x *DescribeUserV1Request) String() string {
+func (x *RestoreUserV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeUserV1Request) ProtoMessage() {}
+func (*RestoreUserV1Request) ProtoMessage() {}
 
-func (x *DescribeUserV1Request) ProtoReflect() protoreflect.Message {
+func (x *RestoreUserV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -371,28 +614,28 @@ func (x *DescribeUserV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeUserV1Request.ProtoReflect.Descriptor instead.
-func (*DescribeUserV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreUserV1Request.ProtoReflect.Descriptor instead.
+func (*RestoreUserV1Request) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *DescribeUserV1Request) GetUserId() uint64 {
+func (x *RestoreUserV1Request) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type DescribeUserV1Response struct {
+type RestoreUserV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Restored bool `protobuf:"varint,1,opt,name=restored,proto3" json:"restored,omitempty"`
 }
 
-func (x *DescribeUserV1Response) Reset() {
-	*x = DescribeUserV1Response{}
+func (x *RestoreUserV1Response) Reset() {
+	*x = RestoreUserV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,13 +643,13 @@ func (x *DescribeUserV1Response) Reset() {
 	}
 }
 
-func (x *DescribeUserV1Response) String() string {
+func (x *RestoreUserV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeUserV1Response) ProtoMessage() {}
+func (*RestoreUserV1Response) ProtoMessage() {}
 
-func (x *DescribeUserV1Response) ProtoReflect() protoreflect.Message {
+func (x *RestoreUserV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -418,28 +661,28 @@ func (x *DescribeUserV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeUserV1Response.ProtoReflect.Descriptor instead.
-func (*DescribeUserV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreUserV1Response.ProtoReflect.Descriptor instead.
+func (*RestoreUserV1Response) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DescribeUserV1Response) GetUser() *User {
+func (x *RestoreUserV1Response) GetRestored() bool {
 	if x != nil {
-		return x.User
+		return x.Restored
 	}
-	return nil
+	return false
 }
 
-type MultiCreateUserV1Request struct {
+type PurgeDeletedUsersV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*UserParams `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	DeletedBefore *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deletedBefore,proto3" json:"deletedBefore,omitempty"`
 }
 
-func (x *MultiCreateUserV1Request) Reset() {
-	*x = MultiCreateUserV1Request{}
+func (x *PurgeDeletedUsersV1Request) Reset() {
+	*x = PurgeDeletedUsersV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -447,13 +690,13 @@ func (x *MultiCreateUserV1Request) Reset() {
 	}
 }
 
-func (x *MultiCreateUserV1Request) String() string {
+func (x *PurgeDeletedUsersV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiCreateUserV1Request) ProtoMessage() {}
+func (*PurgeDeletedUsersV1Request) ProtoMessage() {}
 
-func (x *MultiCreateUserV1Request) ProtoReflect() protoreflect.Message {
+func (x *PurgeDeletedUsersV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -465,28 +708,28 @@ func (x *MultiCreateUserV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiCreateUserV1Request.ProtoReflect.Descriptor instead.
-func (*MultiCreateUserV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeDeletedUsersV1Request.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedUsersV1Request) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MultiCreateUserV1Request) GetUsers() []*UserParams {
+func (x *PurgeDeletedUsersV1Request) GetDeletedBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Users
+		return x.DeletedBefore
 	}
 	return nil
 }
 
-type MultiCreateUserV1Response struct {
+type PurgeDeletedUsersV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Purged uint64 `protobuf:"varint,1,opt,name=purged,proto3" json:"purged,omitempty"`
 }
 
-func (x *MultiCreateUserV1Response) Reset() {
-	*x = MultiCreateUserV1Response{}
+func (x *PurgeDeletedUsersV1Response) Reset() {
+	*x = PurgeDeletedUsersV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,13 +737,13 @@ func (x *MultiCreateUserV1Response) Reset() {
 	}
 }
 
-func (x *MultiCreateUserV1Response) String() string {
+func (x *PurgeDeletedUsersV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MultiCreateUserV1Response) ProtoMessage() {}
+func (*PurgeDeletedUsersV1Response) ProtoMessage() {}
 
-func (x *MultiCreateUserV1Response) ProtoReflect() protoreflect.Message {
+func (x *PurgeDeletedUsersV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -512,29 +755,31 @@ func (x *MultiCreateUserV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MultiCreateUserV1Response.ProtoReflect.Descriptor instead.
-func (*MultiCreateUserV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use PurgeDeletedUsersV1Response.ProtoReflect.Descriptor instead.
+func (*PurgeDeletedUsersV1Response) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *MultiCreateUserV1Response) GetCount() int64 {
+func (x *PurgeDeletedUsersV1Response) GetPurged() uint64 {
 	if x != nil {
-		return x.Count
+		return x.Purged
 	}
 	return 0
 }
 
-type UpdateUserV1Request struct {
+type DescribeUserV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId     uint64      `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	UserParams *UserParams `protobuf:"bytes,2,opt,name=userParams,proto3" json:"userParams,omitempty"`
+	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// Момент, на который восстанавливается состояние пользователя по истории изменений.
+	// Если пользователь на этот момент еще не создан или уже удален, возвращается NOT_FOUND.
+	AsOf *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=asOf,proto3" json:"asOf,omitempty"`
 }
 
-func (x *UpdateUserV1Request) Reset() {
-	*x = UpdateUserV1Request{}
+func (x *DescribeUserV1Request) Reset() {
+	*x = DescribeUserV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -542,13 +787,13 @@ func (x *UpdateUserV1Request) Reset() {
 	}
 }
 
-func (x *UpdateUserV1Request) String() string {
+func (x *DescribeUserV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserV1Request) ProtoMessage() {}
+func (*DescribeUserV1Request) ProtoMessage() {}
 
-func (x *UpdateUserV1Request) ProtoReflect() protoreflect.Message {
+func (x *DescribeUserV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -560,35 +805,35 @@ func (x *UpdateUserV1Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserV1Request.ProtoReflect.Descriptor instead.
-func (*UpdateUserV1Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeUserV1Request.ProtoReflect.Descriptor instead.
+func (*DescribeUserV1Request) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *UpdateUserV1Request) GetUserId() uint64 {
+func (x *DescribeUserV1Request) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateUserV1Request) GetUserParams() *UserParams {
+func (x *DescribeUserV1Request) GetAsOf() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserParams
+		return x.AsOf
 	}
 	return nil
 }
 
-type UpdateUserV1Response struct {
+type DescribeUserV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Updated bool `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	User *User `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (x *UpdateUserV1Response) Reset() {
-	*x = UpdateUserV1Response{}
+func (x *DescribeUserV1Response) Reset() {
+	*x = DescribeUserV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -596,13 +841,13 @@ func (x *UpdateUserV1Response) Reset() {
 	}
 }
 
-func (x *UpdateUserV1Response) String() string {
+func (x *DescribeUserV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserV1Response) ProtoMessage() {}
+func (*DescribeUserV1Response) ProtoMessage() {}
 
-func (x *UpdateUserV1Response) ProtoReflect() protoreflect.Message {
+func (x *DescribeUserV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -614,30 +859,28 @@ func (x *UpdateUserV1Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserV1Response.ProtoReflect.Descriptor instead.
-func (*UpdateUserV1Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeUserV1Response.ProtoReflect.Descriptor instead.
+func (*DescribeUserV1Response) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateUserV1Response) GetUpdated() bool {
+func (x *DescribeUserV1Response) GetUser() *User {
 	if x != nil {
-		return x.Updated
+		return x.User
 	}
-	return false
+	return nil
 }
 
-type UserParams struct {
+type BatchDescribeUsersV1Request struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CalendarId uint64       `protobuf:"varint,1,opt,name=calendarId,proto3" json:"calendarId,omitempty"`
-	ResumeId   uint64       `protobuf:"varint,2,opt,name=resumeId,proto3" json:"resumeId,omitempty"`
-	Profile    *UserProfile `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+	UserIds []uint64 `protobuf:"varint,1,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
 }
 
-func (x *UserParams) Reset() {
-	*x = UserParams{}
+func (x *BatchDescribeUsersV1Request) Reset() {
+	*x = BatchDescribeUsersV1Request{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,13 +888,13 @@ func (x *UserParams) Reset() {
 	}
 }
 
-func (x *UserParams) String() string {
+func (x *BatchDescribeUsersV1Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserParams) ProtoMessage() {}
+func (*BatchDescribeUsersV1Request) ProtoMessage() {}
 
-func (x *UserParams) ProtoReflect() protoreflect.Message {
+func (x *BatchDescribeUsersV1Request) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -663,45 +906,29 @@ func (x *UserParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserParams.ProtoReflect.Descriptor instead.
-func (*UserParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchDescribeUsersV1Request.ProtoReflect.Descriptor instead.
+func (*BatchDescribeUsersV1Request) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UserParams) GetCalendarId() uint64 {
-	if x != nil {
-		return x.CalendarId
-	}
-	return 0
-}
-
-func (x *UserParams) GetResumeId() uint64 {
-	if x != nil {
-		return x.ResumeId
-	}
-	return 0
-}
-
-func (x *UserParams) GetProfile() *UserProfile {
+func (x *BatchDescribeUsersV1Request) GetUserIds() []uint64 {
 	if x != nil {
-		return x.Profile
+		return x.UserIds
 	}
 	return nil
 }
 
-type UserProfile struct {
+type BatchDescribeUsersV1Response struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Surname    string `protobuf:"bytes,2,opt,name=surname,proto3" json:"surname,omitempty"`
-	Patronymic string `protobuf:"bytes,3,opt,name=patronymic,proto3" json:"patronymic,omitempty"`
-	Email      string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Users  []*User      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Errors []*UserError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *UserProfile) Reset() {
-	*x = UserProfile{}
+func (x *BatchDescribeUsersV1Response) Reset() {
+	*x = BatchDescribeUsersV1Response{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,13 +936,13 @@ func (x *UserProfile) Reset() {
 	}
 }
 
-func (x *UserProfile) String() string {
+func (x *BatchDescribeUsersV1Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserProfile) ProtoMessage() {}
+func (*BatchDescribeUsersV1Response) ProtoMessage() {}
 
-func (x *UserProfile) ProtoReflect() protoreflect.Message {
+func (x *BatchDescribeUsersV1Response) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -727,33 +954,1598 @@ func (x *UserProfile) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
-func (*UserProfile) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchDescribeUsersV1Response.ProtoReflect.Descriptor instead.
+func (*BatchDescribeUsersV1Response) Descriptor() ([]byte, []int) {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UserProfile) GetName() string {
+func (x *BatchDescribeUsersV1Response) GetUsers() []*User {
 	if x != nil {
-		return x.Name
+		return x.Users
 	}
-	return ""
+	return nil
 }
 
-func (x *UserProfile) GetSurname() string {
+func (x *BatchDescribeUsersV1Response) GetErrors() []*UserError {
 	if x != nil {
-		return x.Surname
+		return x.Errors
 	}
-	return ""
+	return nil
 }
 
-func (x *UserProfile) GetPatronymic() string {
-	if x != nil {
-		return x.Patronymic
-	}
-	return ""
+type UserError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  uint64           `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Reason  UserError_Reason `protobuf:"varint,2,opt,name=reason,proto3,enum=ocp.user.api.UserError_Reason" json:"reason,omitempty"`
+	Message string           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *UserProfile) GetEmail() string {
+func (x *UserError) Reset() {
+	*x = UserError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserError) ProtoMessage() {}
+
+func (x *UserError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserError.ProtoReflect.Descriptor instead.
+func (*UserError) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UserError) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserError) GetReason() UserError_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return UserError_INTERNAL
+}
+
+func (x *UserError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type MultiCreateUserV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserParams `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Ключ идемпотентности. Может быть передан также в заголовке idempotency-key.
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	// Создание всех пользователей в одной транзакции: либо создаются все пользователи, либо ни один.
+	// Без этого флага пользователи создаются пачками в отдельных транзакциях, а обработка
+	// прекращается на первой неудачной пачке.
+	Atomic bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (x *MultiCreateUserV1Request) Reset() {
+	*x = MultiCreateUserV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateUserV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateUserV1Request) ProtoMessage() {}
+
+func (x *MultiCreateUserV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateUserV1Request.ProtoReflect.Descriptor instead.
+func (*MultiCreateUserV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MultiCreateUserV1Request) GetUsers() []*UserParams {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *MultiCreateUserV1Request) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *MultiCreateUserV1Request) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type MultiCreateUserV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Количество созданных пользователей.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Результаты в порядке пользователей запроса.
+	Results []*CreateUserResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiCreateUserV1Response) Reset() {
+	*x = MultiCreateUserV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiCreateUserV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiCreateUserV1Response) ProtoMessage() {}
+
+func (x *MultiCreateUserV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiCreateUserV1Response.ProtoReflect.Descriptor instead.
+func (*MultiCreateUserV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MultiCreateUserV1Response) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MultiCreateUserV1Response) GetResults() []*CreateUserResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type CreateUserResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Индекс пользователя в запросе.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Types that are assignable to Result:
+	//	*CreateUserResult_UserId
+	//	*CreateUserResult_Error
+	Result isCreateUserResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *CreateUserResult) Reset() {
+	*x = CreateUserResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResult) ProtoMessage() {}
+
+func (x *CreateUserResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResult.ProtoReflect.Descriptor instead.
+func (*CreateUserResult) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateUserResult) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (m *CreateUserResult) GetResult() isCreateUserResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *CreateUserResult) GetUserId() uint64 {
+	if x, ok := x.GetResult().(*CreateUserResult_UserId); ok {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateUserResult) GetError() *status.Status {
+	if x, ok := x.GetResult().(*CreateUserResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateUserResult_Result interface {
+	isCreateUserResult_Result()
+}
+
+type CreateUserResult_UserId struct {
+	UserId uint64 `protobuf:"varint,2,opt,name=userId,proto3,oneof"`
+}
+
+type CreateUserResult_Error struct {
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateUserResult_UserId) isCreateUserResult_Result() {}
+
+func (*CreateUserResult_Error) isCreateUserResult_Result() {}
+
+type UpdateUserV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64      `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserParams *UserParams `protobuf:"bytes,2,opt,name=userParams,proto3" json:"userParams,omitempty"`
+	// Обновляемые поля: calendarId, resumeId, profile, profile.name, profile.surname,
+	// profile.patronymic, profile.email. Пустая маска обновляет все поля.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	// Ожидаемая версия пользователя. Ноль отключает проверку версии.
+	ExpectedVersion uint64 `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *UpdateUserV1Request) Reset() {
+	*x = UpdateUserV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserV1Request) ProtoMessage() {}
+
+func (x *UpdateUserV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserV1Request.ProtoReflect.Descriptor instead.
+func (*UpdateUserV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateUserV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateUserV1Request) GetUserParams() *UserParams {
+	if x != nil {
+		return x.UserParams
+	}
+	return nil
+}
+
+func (x *UpdateUserV1Request) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateUserV1Request) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateUserV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Updated bool   `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *UpdateUserV1Response) Reset() {
+	*x = UpdateUserV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserV1Response) ProtoMessage() {}
+
+func (x *UpdateUserV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserV1Response.ProtoReflect.Descriptor instead.
+func (*UpdateUserV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateUserV1Response) GetUpdated() bool {
+	if x != nil {
+		return x.Updated
+	}
+	return false
+}
+
+func (x *UpdateUserV1Response) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type ExportUsersV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter *UserFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Sort   *UserSort   `protobuf:"bytes,2,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *ExportUsersV1Request) Reset() {
+	*x = ExportUsersV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUsersV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersV1Request) ProtoMessage() {}
+
+func (x *ExportUsersV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersV1Request.ProtoReflect.Descriptor instead.
+func (*ExportUsersV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ExportUsersV1Request) GetFilter() *UserFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportUsersV1Request) GetSort() *UserSort {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
+type ExportUsersV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ExportUsersV1Response) Reset() {
+	*x = ExportUsersV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportUsersV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportUsersV1Response) ProtoMessage() {}
+
+func (x *ExportUsersV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportUsersV1Response.ProtoReflect.Descriptor instead.
+func (*ExportUsersV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ExportUsersV1Response) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ImportUsersV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users []*UserParams `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *ImportUsersV1Request) Reset() {
+	*x = ImportUsersV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUsersV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersV1Request) ProtoMessage() {}
+
+func (x *ImportUsersV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersV1Request.ProtoReflect.Descriptor instead.
+func (*ImportUsersV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ImportUsersV1Request) GetUsers() []*UserParams {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ImportUsersV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Количество полученных пользователей.
+	Received uint64 `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	// Количество созданных пользователей.
+	Created uint64 `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	// Количество пользователей, не прошедших проверку или не сохраненных в БД.
+	Failed uint64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Признак итогового ответа.
+	Done bool `protobuf:"varint,4,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (x *ImportUsersV1Response) Reset() {
+	*x = ImportUsersV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportUsersV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersV1Response) ProtoMessage() {}
+
+func (x *ImportUsersV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersV1Response.ProtoReflect.Descriptor instead.
+func (*ImportUsersV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ImportUsersV1Response) GetReceived() uint64 {
+	if x != nil {
+		return x.Received
+	}
+	return 0
+}
+
+func (x *ImportUsersV1Response) GetCreated() uint64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportUsersV1Response) GetFailed() uint64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportUsersV1Response) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+type MultiUpdateUserV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Изменения пользователей. Каждый пользователь может встречаться не более одного раза.
+	Users []*UserUpdate `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *MultiUpdateUserV1Request) Reset() {
+	*x = MultiUpdateUserV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateUserV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateUserV1Request) ProtoMessage() {}
+
+func (x *MultiUpdateUserV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateUserV1Request.ProtoReflect.Descriptor instead.
+func (*MultiUpdateUserV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MultiUpdateUserV1Request) GetUsers() []*UserUpdate {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type UserUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId     uint64      `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserParams *UserParams `protobuf:"bytes,2,opt,name=userParams,proto3" json:"userParams,omitempty"`
+	// Обновляемые поля, как в UpdateUserV1Request.updateMask.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,3,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	// Ожидаемая версия пользователя. Ноль отключает проверку версии.
+	ExpectedVersion uint64 `protobuf:"varint,4,opt,name=expectedVersion,proto3" json:"expectedVersion,omitempty"`
+}
+
+func (x *UserUpdate) Reset() {
+	*x = UserUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserUpdate) ProtoMessage() {}
+
+func (x *UserUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserUpdate.ProtoReflect.Descriptor instead.
+func (*UserUpdate) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UserUpdate) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserUpdate) GetUserParams() *UserParams {
+	if x != nil {
+		return x.UserParams
+	}
+	return nil
+}
+
+func (x *UserUpdate) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UserUpdate) GetExpectedVersion() uint64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type MultiUpdateUserV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Количество обновленных пользователей.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Результаты в порядке пользователей запроса.
+	Results []*UserChangeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiUpdateUserV1Response) Reset() {
+	*x = MultiUpdateUserV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiUpdateUserV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiUpdateUserV1Response) ProtoMessage() {}
+
+func (x *MultiUpdateUserV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiUpdateUserV1Response.ProtoReflect.Descriptor instead.
+func (*MultiUpdateUserV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MultiUpdateUserV1Response) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MultiUpdateUserV1Response) GetResults() []*UserChangeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MultiRemoveUserV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserIds []uint64 `protobuf:"varint,1,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+}
+
+func (x *MultiRemoveUserV1Request) Reset() {
+	*x = MultiRemoveUserV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveUserV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveUserV1Request) ProtoMessage() {}
+
+func (x *MultiRemoveUserV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveUserV1Request.ProtoReflect.Descriptor instead.
+func (*MultiRemoveUserV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MultiRemoveUserV1Request) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type MultiRemoveUserV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Количество удаленных пользователей.
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Результаты в порядке идентификаторов запроса.
+	Results []*UserChangeResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *MultiRemoveUserV1Response) Reset() {
+	*x = MultiRemoveUserV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MultiRemoveUserV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiRemoveUserV1Response) ProtoMessage() {}
+
+func (x *MultiRemoveUserV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiRemoveUserV1Response.ProtoReflect.Descriptor instead.
+func (*MultiRemoveUserV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *MultiRemoveUserV1Response) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *MultiRemoveUserV1Response) GetResults() []*UserChangeResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UserChangeResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// Types that are assignable to Result:
+	//	*UserChangeResult_Version
+	//	*UserChangeResult_Error
+	Result isUserChangeResult_Result `protobuf_oneof:"result"`
+}
+
+func (x *UserChangeResult) Reset() {
+	*x = UserChangeResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserChangeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserChangeResult) ProtoMessage() {}
+
+func (x *UserChangeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserChangeResult.ProtoReflect.Descriptor instead.
+func (*UserChangeResult) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserChangeResult) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (m *UserChangeResult) GetResult() isUserChangeResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *UserChangeResult) GetVersion() uint64 {
+	if x, ok := x.GetResult().(*UserChangeResult_Version); ok {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserChangeResult) GetError() *status.Status {
+	if x, ok := x.GetResult().(*UserChangeResult_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUserChangeResult_Result interface {
+	isUserChangeResult_Result()
+}
+
+type UserChangeResult_Version struct {
+	// Новая версия измененного пользователя.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3,oneof"`
+}
+
+type UserChangeResult_Error struct {
+	Error *status.Status `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*UserChangeResult_Version) isUserChangeResult_Result() {}
+
+func (*UserChangeResult_Error) isUserChangeResult_Result() {}
+
+type ListUserHistoryV1Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    uint64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Limit     uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListUserHistoryV1Request) Reset() {
+	*x = ListUserHistoryV1Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserHistoryV1Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserHistoryV1Request) ProtoMessage() {}
+
+func (x *ListUserHistoryV1Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserHistoryV1Request.ProtoReflect.Descriptor instead.
+func (*ListUserHistoryV1Request) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListUserHistoryV1Request) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListUserHistoryV1Request) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUserHistoryV1Request) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserHistoryV1Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries       []*UserHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string              `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ListUserHistoryV1Response) Reset() {
+	*x = ListUserHistoryV1Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserHistoryV1Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserHistoryV1Response) ProtoMessage() {}
+
+func (x *ListUserHistoryV1Response) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserHistoryV1Response.ProtoReflect.Descriptor instead.
+func (*ListUserHistoryV1Response) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListUserHistoryV1Response) GetEntries() []*UserHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListUserHistoryV1Response) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UserHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    uint64                     `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Operation UserHistoryEntry_Operation `protobuf:"varint,3,opt,name=operation,proto3,enum=ocp.user.api.UserHistoryEntry_Operation" json:"operation,omitempty"`
+	// Инициатор изменения из заголовка x-actor. Пустой, если инициатор не передан.
+	Actor string `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Идентификатор трассы запроса, выполнившего изменение.
+	TraceId string `protobuf:"bytes,5,opt,name=traceId,proto3" json:"traceId,omitempty"`
+	// Версия пользователя после изменения.
+	Version   uint64                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	// Измененные поля в порядке полей пользователя.
+	Changes []*FieldChange `protobuf:"bytes,8,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *UserHistoryEntry) Reset() {
+	*x = UserHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserHistoryEntry) ProtoMessage() {}
+
+func (x *UserHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserHistoryEntry.ProtoReflect.Descriptor instead.
+func (*UserHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UserHistoryEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserHistoryEntry) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserHistoryEntry) GetOperation() UserHistoryEntry_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return UserHistoryEntry_CREATE
+}
+
+func (x *UserHistoryEntry) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *UserHistoryEntry) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *UserHistoryEntry) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserHistoryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserHistoryEntry) GetChanges() []*FieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+// Значения поля до и после изменения в текстовом виде. Отсутствующее значение не заполняется:
+// before при создании пользователя, значение deletedAt у неудаленного пользователя.
+type FieldChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Путь поля: calendarId, resumeId, profile.name, profile.surname, profile.patronymic, profile.email, deletedAt.
+	Field  string                  `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Before *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *FieldChange) Reset() {
+	*x = FieldChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldChange) ProtoMessage() {}
+
+func (x *FieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldChange.ProtoReflect.Descriptor instead.
+func (*FieldChange) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldChange) GetBefore() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *FieldChange) GetAfter() *wrapperspb.StringValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+type UserParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CalendarId uint64       `protobuf:"varint,1,opt,name=calendarId,proto3" json:"calendarId,omitempty"`
+	ResumeId   uint64       `protobuf:"varint,2,opt,name=resumeId,proto3" json:"resumeId,omitempty"`
+	Profile    *UserProfile `protobuf:"bytes,3,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UserParams) Reset() {
+	*x = UserParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserParams) ProtoMessage() {}
+
+func (x *UserParams) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserParams.ProtoReflect.Descriptor instead.
+func (*UserParams) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UserParams) GetCalendarId() uint64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *UserParams) GetResumeId() uint64 {
+	if x != nil {
+		return x.ResumeId
+	}
+	return 0
+}
+
+func (x *UserParams) GetProfile() *UserProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UserFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Surname    string `protobuf:"bytes,2,opt,name=surname,proto3" json:"surname,omitempty"`
+	Patronymic string `protobuf:"bytes,3,opt,name=patronymic,proto3" json:"patronymic,omitempty"`
+	Email      string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	CalendarId uint64 `protobuf:"varint,5,opt,name=calendarId,proto3" json:"calendarId,omitempty"`
+	ResumeId   uint64 `protobuf:"varint,6,opt,name=resumeId,proto3" json:"resumeId,omitempty"`
+	// Включать в выборку удаленных пользователей.
+	IncludeDeleted bool `protobuf:"varint,7,opt,name=includeDeleted,proto3" json:"includeDeleted,omitempty"`
+}
+
+func (x *UserFilter) Reset() {
+	*x = UserFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserFilter) ProtoMessage() {}
+
+func (x *UserFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserFilter.ProtoReflect.Descriptor instead.
+func (*UserFilter) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *UserFilter) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserFilter) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UserFilter) GetPatronymic() string {
+	if x != nil {
+		return x.Patronymic
+	}
+	return ""
+}
+
+func (x *UserFilter) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserFilter) GetCalendarId() uint64 {
+	if x != nil {
+		return x.CalendarId
+	}
+	return 0
+}
+
+func (x *UserFilter) GetResumeId() uint64 {
+	if x != nil {
+		return x.ResumeId
+	}
+	return 0
+}
+
+func (x *UserFilter) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type UserSort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field     UserSort_Field     `protobuf:"varint,1,opt,name=field,proto3,enum=ocp.user.api.UserSort_Field" json:"field,omitempty"`
+	Direction UserSort_Direction `protobuf:"varint,2,opt,name=direction,proto3,enum=ocp.user.api.UserSort_Direction" json:"direction,omitempty"`
+}
+
+func (x *UserSort) Reset() {
+	*x = UserSort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserSort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSort) ProtoMessage() {}
+
+func (x *UserSort) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSort.ProtoReflect.Descriptor instead.
+func (*UserSort) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UserSort) GetField() UserSort_Field {
+	if x != nil {
+		return x.Field
+	}
+	return UserSort_ID
+}
+
+func (x *UserSort) GetDirection() UserSort_Direction {
+	if x != nil {
+		return x.Direction
+	}
+	return UserSort_ASC
+}
+
+// Имя, фамилия и отчество состоят из букв, разделенных одиночными пробелами, дефисами или апострофами.
+type UserProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Surname string `protobuf:"bytes,2,opt,name=surname,proto3" json:"surname,omitempty"`
+	// Необязательное поле.
+	Patronymic string `protobuf:"bytes,3,opt,name=patronymic,proto3" json:"patronymic,omitempty"`
+	// Необязательное поле.
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *UserProfile) Reset() {
+	*x = UserProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserProfile) ProtoMessage() {}
+
+func (x *UserProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserProfile.ProtoReflect.Descriptor instead.
+func (*UserProfile) Descriptor() ([]byte, []int) {
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UserProfile) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UserProfile) GetPatronymic() string {
+	if x != nil {
+		return x.Patronymic
+	}
+	return ""
+}
+
+func (x *UserProfile) GetEmail() string {
 	if x != nil {
 		return x.Email
 	}
@@ -769,12 +2561,15 @@ type User struct {
 	CalendarId uint64       `protobuf:"varint,2,opt,name=calendarId,proto3" json:"calendarId,omitempty"`
 	ResumeId   uint64       `protobuf:"varint,3,opt,name=resumeId,proto3" json:"resumeId,omitempty"`
 	Profile    *UserProfile `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`
+	Version    uint64       `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	// Время удаления. Заполняется только для удаленных пользователей.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
 }
 
 func (x *User) Reset() {
 	*x = User{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[14]
+		mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -787,7 +2582,7 @@ func (x *User) String() string {
 func (*User) ProtoMessage() {}
 
 func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[14]
+	mi := &file_api_ocp_user_api_ocp_user_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +2595,7 @@ func (x *User) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use User.ProtoReflect.Descriptor instead.
 func (*User) Descriptor() ([]byte, []int) {
-	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{14}
+	return file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *User) GetId() uint64 {
@@ -831,6 +2626,20 @@ func (x *User) GetProfile() *UserProfile {
 	return nil
 }
 
+func (x *User) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *User) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
 var File_api_ocp_user_api_ocp_user_api_proto protoreflect.FileDescriptor
 
 var file_api_ocp_user_api_ocp_user_api_proto_rawDesc = []byte{
@@ -839,140 +2648,464 @@ var file_api_ocp_user_api_ocp_user_api_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
 	0x61, 0x70, 0x69, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x41, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e,
-	0x76, 0x6f, 0x79, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x5f, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x28, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78,
-	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e,
-	0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x13, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a,
-	0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
-	0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x36, 0x0a, 0x13,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x41, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x70,
+	0x72, 0x6f, 0x78, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb4, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x30, 0x0a,
+	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x2a, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x52,
+	0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x77, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4a, 0x04,
+	0x08, 0x02, 0x10, 0x03, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
+	0x22, 0xd4, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x2e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x60, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x37, 0x0a, 0x14, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0x33, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x1a, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xb2,
+	0x01, 0x02, 0x08, 0x01, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x70, 0x75, 0x72, 0x67, 0x65, 0x64, 0x22, 0x68, 0x0a, 0x15, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x38, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04,
+	0x61, 0x73, 0x4f, 0x66, 0x22, 0x40, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x1b, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x11, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x08, 0x01,
+	0x10, 0xe8, 0x07, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x07, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x22, 0x79, 0x0a, 0x1c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x9c, 0x01,
+	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x25, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x0d, 0x0a,
+	0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x22, 0xa1, 0x01, 0x0a,
+	0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x74, 0x6f, 0x6d,
+	0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63,
+	0x22, 0x6b, 0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x78, 0x0a,
+	0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x2a, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xe0, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
 	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x40, 0x0a, 0x16, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x22, 0x4a, 0x0a, 0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0x31,
-	0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x7a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
-	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20,
-	0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x75, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x12, 0x42, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61,
+	0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b,
+	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4a, 0x0a, 0x14, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x14, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x2a, 0x0a, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74, 0x22, 0x41, 0x0a, 0x15,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22,
+	0x51, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x09, 0xfa, 0x42, 0x06, 0x92, 0x01, 0x03, 0x10, 0xe8, 0x07, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x22, 0x79, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x22, 0x57, 0x0a,
+	0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52,
+	0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xd7, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x6b, 0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x49, 0x0a,
+	0x18, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x13, 0xfa, 0x42, 0x10, 0x92,
+	0x01, 0x0d, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x18, 0x01, 0x22, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x6b, 0x0a, 0x19, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7c, 0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1a, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x48, 0x00, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x7b, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x20, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0a, 0xfa, 0x42, 0x07, 0x32, 0x05, 0x18, 0xe8, 0x07, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x7b, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf9, 0x02,
+	0x0a, 0x10, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x09, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e,
 	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
-	0x01, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x30, 0x0a,
-	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22,
-	0x87, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63,
-	0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x71, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07,
-	0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73,
-	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e,
-	0x79, 0x6d, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x72,
-	0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x90, 0x01, 0x0a,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e,
-	0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63,
-	0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x32,
-	0xc5, 0x05, 0x0a, 0x0a, 0x4f, 0x63, 0x70, 0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x69, 0x12, 0x65,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x12, 0x20, 0x2e,
-	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x77, 0x0a, 0x0e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x12, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
-	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f,
-	0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0x6b,
-	0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x12, 0x21,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f,
-	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x0c, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63,
-	0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a, 0x12, 0x2f, 0x76, 0x31, 0x2f,
-	0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0x80,
-	0x01, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f,
-	0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74,
-	0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f,
-	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x3a, 0x01,
-	0x2a, 0x12, 0x74, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
-	0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x09, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x01,
+	0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07,
+	0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x34, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x0a, 0x55, 0x73,
+	0x65, 0x72, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x27, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49,
+	0x64, 0x12, 0x23, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x72, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x63,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x79, 0x6d,
+	0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65,
+	0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x95, 0x02, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6f, 0x72, 0x74,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x48, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x63, 0x70,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x6f,
+	0x72, 0x74, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x61, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x44,
+	0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x55, 0x52, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x41, 0x54,
+	0x52, 0x4f, 0x4e, 0x59, 0x4d, 0x49, 0x43, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41,
+	0x49, 0x4c, 0x10, 0x04, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x4c, 0x45, 0x4e, 0x44, 0x41, 0x52,
+	0x5f, 0x49, 0x44, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x52, 0x45, 0x53, 0x55, 0x4d, 0x45, 0x5f,
+	0x49, 0x44, 0x10, 0x06, 0x22, 0x1e, 0x0a, 0x09, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x53, 0x43, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x45,
+	0x53, 0x43, 0x10, 0x01, 0x22, 0xea, 0x01, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x21, 0xfa, 0x42, 0x1e, 0x72, 0x1c, 0x10, 0x01, 0x18, 0x64, 0x32, 0x16, 0x5e,
+	0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x2b, 0x28, 0x5b, 0x20, 0x27, 0x2d, 0x5d, 0x5c, 0x70, 0x7b, 0x4c,
+	0x7d, 0x2b, 0x29, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73,
+	0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x21, 0xfa, 0x42,
+	0x1e, 0x72, 0x1c, 0x10, 0x01, 0x18, 0x64, 0x32, 0x16, 0x5e, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x2b,
+	0x28, 0x5b, 0x20, 0x27, 0x2d, 0x5d, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x2b, 0x29, 0x2a, 0x24, 0x52,
+	0x07, 0x73, 0x75, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x72,
+	0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xfa, 0x42,
+	0x1f, 0x72, 0x1d, 0x18, 0x64, 0x32, 0x16, 0x5e, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x2b, 0x28, 0x5b,
+	0x20, 0x27, 0x2d, 0x5d, 0x5c, 0x70, 0x7b, 0x4c, 0x7d, 0x2b, 0x29, 0x2a, 0x24, 0xd0, 0x01, 0x01,
+	0x52, 0x0a, 0x70, 0x61, 0x74, 0x72, 0x6f, 0x6e, 0x79, 0x6d, 0x69, 0x63, 0x12, 0x23, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xfa, 0x42, 0x0a,
+	0x72, 0x08, 0x18, 0xfe, 0x01, 0x60, 0x01, 0xd0, 0x01, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x22, 0xe4, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x32, 0x02, 0x20, 0x00, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x49, 0x64, 0x12,
+	0x33, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x32, 0x9e, 0x0e, 0x0a, 0x0a, 0x4f, 0x63, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x41, 0x70, 0x69, 0x12, 0x65, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x12, 0x20, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x12, 0x77,
+	0x0a, 0x0e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31,
+	0x12, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x12, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6f, 0x63,
+	0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a,
+	0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31,
+	0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x2a,
+	0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x7d, 0x12, 0x7c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x56, 0x31, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x12, 0x28, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72,
+	0x73, 0x3a, 0x70, 0x75, 0x72, 0x67, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x3a, 0x01,
+	0x2a, 0x12, 0x80, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
+	0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x3a, 0x01, 0x2a, 0x12, 0x74, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x56, 0x31, 0x12, 0x21, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
-	0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x68, 0x74, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f, 0x63, 0x70,
-	0x2d, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x63,
-	0x70, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f, 0x63, 0x70, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31,
+	0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56,
+	0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x3a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5a, 0x0a, 0x0d,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x12, 0x22, 0x2e,
+	0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70,
+	0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x68, 0x0a, 0x19, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x56, 0x31, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01,
+	0x30, 0x01, 0x12, 0x5a, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x56, 0x31, 0x12, 0x22, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x31,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x56, 0x31, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x88,
+	0x01, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x56, 0x31, 0x12, 0x26, 0x2e, 0x6f, 0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f,
+	0x63, 0x70, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x56, 0x31, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x68,
+	0x74, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x7a, 0x6f, 0x6e, 0x63, 0x70, 0x2f, 0x6f,
+	0x63, 0x70, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
+	0x6f, 0x63, 0x70, 0x2d, 0x75, 0x73, 0x65, 0x72, 0x2d, 0x61, 0x70, 0x69, 0x3b, 0x6f, 0x63, 0x70,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -987,49 +3120,129 @@ func file_api_ocp_user_api_ocp_user_api_proto_rawDescGZIP() []byte {
 	return file_api_ocp_user_api_ocp_user_api_proto_rawDescData
 }
 
-var file_api_ocp_user_api_ocp_user_api_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_api_ocp_user_api_ocp_user_api_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_ocp_user_api_ocp_user_api_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_api_ocp_user_api_ocp_user_api_proto_goTypes = []interface{}{
-	(*ListUsersV1Request)(nil),        // 0: ocp.user.api.ListUsersV1Request
-	(*ListUsersV1Response)(nil),       // 1: ocp.user.api.ListUsersV1Response
-	(*CreateUserV1Request)(nil),       // 2: ocp.user.api.CreateUserV1Request
-	(*CreateUserV1Response)(nil),      // 3: ocp.user.api.CreateUserV1Response
-	(*RemoveUserV1Request)(nil),       // 4: ocp.user.api.RemoveUserV1Request
-	(*RemoveUserV1Response)(nil),      // 5: ocp.user.api.RemoveUserV1Response
-	(*DescribeUserV1Request)(nil),     // 6: ocp.user.api.DescribeUserV1Request
-	(*DescribeUserV1Response)(nil),    // 7: ocp.user.api.DescribeUserV1Response
-	(*MultiCreateUserV1Request)(nil),  // 8: ocp.user.api.MultiCreateUserV1Request
-	(*MultiCreateUserV1Response)(nil), // 9: ocp.user.api.MultiCreateUserV1Response
-	(*UpdateUserV1Request)(nil),       // 10: ocp.user.api.UpdateUserV1Request
-	(*UpdateUserV1Response)(nil),      // 11: ocp.user.api.UpdateUserV1Response
-	(*UserParams)(nil),                // 12: ocp.user.api.UserParams
-	(*UserProfile)(nil),               // 13: ocp.user.api.UserProfile
-	(*User)(nil),                      // 14: ocp.user.api.User
+	(UserError_Reason)(0),                // 0: ocp.user.api.UserError.Reason
+	(UserHistoryEntry_Operation)(0),      // 1: ocp.user.api.UserHistoryEntry.Operation
+	(UserSort_Field)(0),                  // 2: ocp.user.api.UserSort.Field
+	(UserSort_Direction)(0),              // 3: ocp.user.api.UserSort.Direction
+	(*ListUsersV1Request)(nil),           // 4: ocp.user.api.ListUsersV1Request
+	(*ListUsersV1Response)(nil),          // 5: ocp.user.api.ListUsersV1Response
+	(*CreateUserV1Request)(nil),          // 6: ocp.user.api.CreateUserV1Request
+	(*CreateUserV1Response)(nil),         // 7: ocp.user.api.CreateUserV1Response
+	(*RemoveUserV1Request)(nil),          // 8: ocp.user.api.RemoveUserV1Request
+	(*RemoveUserV1Response)(nil),         // 9: ocp.user.api.RemoveUserV1Response
+	(*RestoreUserV1Request)(nil),         // 10: ocp.user.api.RestoreUserV1Request
+	(*RestoreUserV1Response)(nil),        // 11: ocp.user.api.RestoreUserV1Response
+	(*PurgeDeletedUsersV1Request)(nil),   // 12: ocp.user.api.PurgeDeletedUsersV1Request
+	(*PurgeDeletedUsersV1Response)(nil),  // 13: ocp.user.api.PurgeDeletedUsersV1Response
+	(*DescribeUserV1Request)(nil),        // 14: ocp.user.api.DescribeUserV1Request
+	(*DescribeUserV1Response)(nil),       // 15: ocp.user.api.DescribeUserV1Response
+	(*BatchDescribeUsersV1Request)(nil),  // 16: ocp.user.api.BatchDescribeUsersV1Request
+	(*BatchDescribeUsersV1Response)(nil), // 17: ocp.user.api.BatchDescribeUsersV1Response
+	(*UserError)(nil),                    // 18: ocp.user.api.UserError
+	(*MultiCreateUserV1Request)(nil),     // 19: ocp.user.api.MultiCreateUserV1Request
+	(*MultiCreateUserV1Response)(nil),    // 20: ocp.user.api.MultiCreateUserV1Response
+	(*CreateUserResult)(nil),             // 21: ocp.user.api.CreateUserResult
+	(*UpdateUserV1Request)(nil),          // 22: ocp.user.api.UpdateUserV1Request
+	(*UpdateUserV1Response)(nil),         // 23: ocp.user.api.UpdateUserV1Response
+	(*ExportUsersV1Request)(nil),         // 24: ocp.user.api.ExportUsersV1Request
+	(*ExportUsersV1Response)(nil),        // 25: ocp.user.api.ExportUsersV1Response
+	(*ImportUsersV1Request)(nil),         // 26: ocp.user.api.ImportUsersV1Request
+	(*ImportUsersV1Response)(nil),        // 27: ocp.user.api.ImportUsersV1Response
+	(*MultiUpdateUserV1Request)(nil),     // 28: ocp.user.api.MultiUpdateUserV1Request
+	(*UserUpdate)(nil),                   // 29: ocp.user.api.UserUpdate
+	(*MultiUpdateUserV1Response)(nil),    // 30: ocp.user.api.MultiUpdateUserV1Response
+	(*MultiRemoveUserV1Request)(nil),     // 31: ocp.user.api.MultiRemoveUserV1Request
+	(*MultiRemoveUserV1Response)(nil),    // 32: ocp.user.api.MultiRemoveUserV1Response
+	(*UserChangeResult)(nil),             // 33: ocp.user.api.UserChangeResult
+	(*ListUserHistoryV1Request)(nil),     // 34: ocp.user.api.ListUserHistoryV1Request
+	(*ListUserHistoryV1Response)(nil),    // 35: ocp.user.api.ListUserHistoryV1Response
+	(*UserHistoryEntry)(nil),             // 36: ocp.user.api.UserHistoryEntry
+	(*FieldChange)(nil),                  // 37: ocp.user.api.FieldChange
+	(*UserParams)(nil),                   // 38: ocp.user.api.UserParams
+	(*UserFilter)(nil),                   // 39: ocp.user.api.UserFilter
+	(*UserSort)(nil),                     // 40: ocp.user.api.UserSort
+	(*UserProfile)(nil),                  // 41: ocp.user.api.UserProfile
+	(*User)(nil),                         // 42: ocp.user.api.User
+	(*timestamppb.Timestamp)(nil),        // 43: google.protobuf.Timestamp
+	(*status.Status)(nil),                // 44: google.rpc.Status
+	(*fieldmaskpb.FieldMask)(nil),        // 45: google.protobuf.FieldMask
+	(*wrapperspb.StringValue)(nil),       // 46: google.protobuf.StringValue
 }
 var file_api_ocp_user_api_ocp_user_api_proto_depIdxs = []int32{
-	14, // 0: ocp.user.api.ListUsersV1Response.users:type_name -> ocp.user.api.User
-	13, // 1: ocp.user.api.CreateUserV1Request.profile:type_name -> ocp.user.api.UserProfile
-	14, // 2: ocp.user.api.DescribeUserV1Response.user:type_name -> ocp.user.api.User
-	12, // 3: ocp.user.api.MultiCreateUserV1Request.users:type_name -> ocp.user.api.UserParams
-	12, // 4: ocp.user.api.UpdateUserV1Request.userParams:type_name -> ocp.user.api.UserParams
-	13, // 5: ocp.user.api.UserParams.profile:type_name -> ocp.user.api.UserProfile
-	13, // 6: ocp.user.api.User.profile:type_name -> ocp.user.api.UserProfile
-	0,  // 7: ocp.user.api.OcpUserApi.ListUsersV1:input_type -> ocp.user.api.ListUsersV1Request
-	6,  // 8: ocp.user.api.OcpUserApi.DescribeUserV1:input_type -> ocp.user.api.DescribeUserV1Request
-	2,  // 9: ocp.user.api.OcpUserApi.CreateUserV1:input_type -> ocp.user.api.CreateUserV1Request
-	4,  // 10: ocp.user.api.OcpUserApi.RemoveUserV1:input_type -> ocp.user.api.RemoveUserV1Request
-	8,  // 11: ocp.user.api.OcpUserApi.MultiCreateUserV1:input_type -> ocp.user.api.MultiCreateUserV1Request
-	10, // 12: ocp.user.api.OcpUserApi.UpdateUserV1:input_type -> ocp.user.api.UpdateUserV1Request
-	1,  // 13: ocp.user.api.OcpUserApi.ListUsersV1:output_type -> ocp.user.api.ListUsersV1Response
-	7,  // 14: ocp.user.api.OcpUserApi.DescribeUserV1:output_type -> ocp.user.api.DescribeUserV1Response
-	3,  // 15: ocp.user.api.OcpUserApi.CreateUserV1:output_type -> ocp.user.api.CreateUserV1Response
-	5,  // 16: ocp.user.api.OcpUserApi.RemoveUserV1:output_type -> ocp.user.api.RemoveUserV1Response
-	9,  // 17: ocp.user.api.OcpUserApi.MultiCreateUserV1:output_type -> ocp.user.api.MultiCreateUserV1Response
-	11, // 18: ocp.user.api.OcpUserApi.UpdateUserV1:output_type -> ocp.user.api.UpdateUserV1Response
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	39, // 0: ocp.user.api.ListUsersV1Request.filter:type_name -> ocp.user.api.UserFilter
+	40, // 1: ocp.user.api.ListUsersV1Request.sort:type_name -> ocp.user.api.UserSort
+	42, // 2: ocp.user.api.ListUsersV1Response.users:type_name -> ocp.user.api.User
+	41, // 3: ocp.user.api.CreateUserV1Request.profile:type_name -> ocp.user.api.UserProfile
+	43, // 4: ocp.user.api.PurgeDeletedUsersV1Request.deletedBefore:type_name -> google.protobuf.Timestamp
+	43, // 5: ocp.user.api.DescribeUserV1Request.asOf:type_name -> google.protobuf.Timestamp
+	42, // 6: ocp.user.api.DescribeUserV1Response.user:type_name -> ocp.user.api.User
+	42, // 7: ocp.user.api.BatchDescribeUsersV1Response.users:type_name -> ocp.user.api.User
+	18, // 8: ocp.user.api.BatchDescribeUsersV1Response.errors:type_name -> ocp.user.api.UserError
+	0,  // 9: ocp.user.api.UserError.reason:type_name -> ocp.user.api.UserError.Reason
+	38, // 10: ocp.user.api.MultiCreateUserV1Request.users:type_name -> ocp.user.api.UserParams
+	21, // 11: ocp.user.api.MultiCreateUserV1Response.results:type_name -> ocp.user.api.CreateUserResult
+	44, // 12: ocp.user.api.CreateUserResult.error:type_name -> google.rpc.Status
+	38, // 13: ocp.user.api.UpdateUserV1Request.userParams:type_name -> ocp.user.api.UserParams
+	45, // 14: ocp.user.api.UpdateUserV1Request.updateMask:type_name -> google.protobuf.FieldMask
+	39, // 15: ocp.user.api.ExportUsersV1Request.filter:type_name -> ocp.user.api.UserFilter
+	40, // 16: ocp.user.api.ExportUsersV1Request.sort:type_name -> ocp.user.api.UserSort
+	42, // 17: ocp.user.api.ExportUsersV1Response.users:type_name -> ocp.user.api.User
+	38, // 18: ocp.user.api.ImportUsersV1Request.users:type_name -> ocp.user.api.UserParams
+	29, // 19: ocp.user.api.MultiUpdateUserV1Request.users:type_name -> ocp.user.api.UserUpdate
+	38, // 20: ocp.user.api.UserUpdate.userParams:type_name -> ocp.user.api.UserParams
+	45, // 21: ocp.user.api.UserUpdate.updateMask:type_name -> google.protobuf.FieldMask
+	33, // 22: ocp.user.api.MultiUpdateUserV1Response.results:type_name -> ocp.user.api.UserChangeResult
+	33, // 23: ocp.user.api.MultiRemoveUserV1Response.results:type_name -> ocp.user.api.UserChangeResult
+	44, // 24: ocp.user.api.UserChangeResult.error:type_name -> google.rpc.Status
+	36, // 25: ocp.user.api.ListUserHistoryV1Response.entries:type_name -> ocp.user.api.UserHistoryEntry
+	1,  // 26: ocp.user.api.UserHistoryEntry.operation:type_name -> ocp.user.api.UserHistoryEntry.Operation
+	43, // 27: ocp.user.api.UserHistoryEntry.createdAt:type_name -> google.protobuf.Timestamp
+	37, // 28: ocp.user.api.UserHistoryEntry.changes:type_name -> ocp.user.api.FieldChange
+	46, // 29: ocp.user.api.FieldChange.before:type_name -> google.protobuf.StringValue
+	46, // 30: ocp.user.api.FieldChange.after:type_name -> google.protobuf.StringValue
+	41, // 31: ocp.user.api.UserParams.profile:type_name -> ocp.user.api.UserProfile
+	2,  // 32: ocp.user.api.UserSort.field:type_name -> ocp.user.api.UserSort.Field
+	3,  // 33: ocp.user.api.UserSort.direction:type_name -> ocp.user.api.UserSort.Direction
+	41, // 34: ocp.user.api.User.profile:type_name -> ocp.user.api.UserProfile
+	43, // 35: ocp.user.api.User.deletedAt:type_name -> google.protobuf.Timestamp
+	4,  // 36: ocp.user.api.OcpUserApi.ListUsersV1:input_type -> ocp.user.api.ListUsersV1Request
+	14, // 37: ocp.user.api.OcpUserApi.DescribeUserV1:input_type -> ocp.user.api.DescribeUserV1Request
+	16, // 38: ocp.user.api.OcpUserApi.BatchDescribeUsersV1:input_type -> ocp.user.api.BatchDescribeUsersV1Request
+	6,  // 39: ocp.user.api.OcpUserApi.CreateUserV1:input_type -> ocp.user.api.CreateUserV1Request
+	8,  // 40: ocp.user.api.OcpUserApi.RemoveUserV1:input_type -> ocp.user.api.RemoveUserV1Request
+	10, // 41: ocp.user.api.OcpUserApi.RestoreUserV1:input_type -> ocp.user.api.RestoreUserV1Request
+	12, // 42: ocp.user.api.OcpUserApi.PurgeDeletedUsersV1:input_type -> ocp.user.api.PurgeDeletedUsersV1Request
+	19, // 43: ocp.user.api.OcpUserApi.MultiCreateUserV1:input_type -> ocp.user.api.MultiCreateUserV1Request
+	22, // 44: ocp.user.api.OcpUserApi.UpdateUserV1:input_type -> ocp.user.api.UpdateUserV1Request
+	28, // 45: ocp.user.api.OcpUserApi.MultiUpdateUserV1:input_type -> ocp.user.api.MultiUpdateUserV1Request
+	31, // 46: ocp.user.api.OcpUserApi.MultiRemoveUserV1:input_type -> ocp.user.api.MultiRemoveUserV1Request
+	26, // 47: ocp.user.api.OcpUserApi.ImportUsersV1:input_type -> ocp.user.api.ImportUsersV1Request
+	26, // 48: ocp.user.api.OcpUserApi.ImportUsersWithProgressV1:input_type -> ocp.user.api.ImportUsersV1Request
+	24, // 49: ocp.user.api.OcpUserApi.ExportUsersV1:input_type -> ocp.user.api.ExportUsersV1Request
+	34, // 50: ocp.user.api.OcpUserApi.ListUserHistoryV1:input_type -> ocp.user.api.ListUserHistoryV1Request
+	5,  // 51: ocp.user.api.OcpUserApi.ListUsersV1:output_type -> ocp.user.api.ListUsersV1Response
+	15, // 52: ocp.user.api.OcpUserApi.DescribeUserV1:output_type -> ocp.user.api.DescribeUserV1Response
+	17, // 53: ocp.user.api.OcpUserApi.BatchDescribeUsersV1:output_type -> ocp.user.api.BatchDescribeUsersV1Response
+	7,  // 54: ocp.user.api.OcpUserApi.CreateUserV1:output_type -> ocp.user.api.CreateUserV1Response
+	9,  // 55: ocp.user.api.OcpUserApi.RemoveUserV1:output_type -> ocp.user.api.RemoveUserV1Response
+	11, // 56: ocp.user.api.OcpUserApi.RestoreUserV1:output_type -> ocp.user.api.RestoreUserV1Response
+	13, // 57: ocp.user.api.OcpUserApi.PurgeDeletedUsersV1:output_type -> ocp.user.api.PurgeDeletedUsersV1Response
+	20, // 58: ocp.user.api.OcpUserApi.MultiCreateUserV1:output_type -> ocp.user.api.MultiCreateUserV1Response
+	23, // 59: ocp.user.api.OcpUserApi.UpdateUserV1:output_type -> ocp.user.api.UpdateUserV1Response
+	30, // 60: ocp.user.api.OcpUserApi.MultiUpdateUserV1:output_type -> ocp.user.api.MultiUpdateUserV1Response
+	32, // 61: ocp.user.api.OcpUserApi.MultiRemoveUserV1:output_type -> ocp.user.api.MultiRemoveUserV1Response
+	27, // 62: ocp.user.api.OcpUserApi.ImportUsersV1:output_type -> ocp.user.api.ImportUsersV1Response
+	27, // 63: ocp.user.api.OcpUserApi.ImportUsersWithProgressV1:output_type -> ocp.user.api.ImportUsersV1Response
+	25, // 64: ocp.user.api.OcpUserApi.ExportUsersV1:output_type -> ocp.user.api.ExportUsersV1Response
+	35, // 65: ocp.user.api.OcpUserApi.ListUserHistoryV1:output_type -> ocp.user.api.ListUserHistoryV1Response
+	51, // [51:66] is the sub-list for method output_type
+	36, // [36:51] is the sub-list for method input_type
+	36, // [36:36] is the sub-list for extension type_name
+	36, // [36:36] is the sub-list for extension extendee
+	0,  // [0:36] is the sub-list for field type_name
 }
 
 func init() { file_api_ocp_user_api_ocp_user_api_proto_init() }
@@ -1050,8 +3263,32 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUsersV1Response); i {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUsersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1062,8 +3299,8 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserV1Request); i {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUserV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1074,8 +3311,8 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserV1Response); i {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUserV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1086,8 +3323,8 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveUserV1Request); i {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreUserV1Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1098,8 +3335,8 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveUserV1Response); i {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreUserV1Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1110,7 +3347,31 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDeletedUsersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeDeletedUsersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DescribeUserV1Request); i {
 			case 0:
 				return &v.state
@@ -1122,7 +3383,7 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DescribeUserV1Response); i {
 			case 0:
 				return &v.state
@@ -1134,7 +3395,43 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDescribeUsersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDescribeUsersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MultiCreateUserV1Request); i {
 			case 0:
 				return &v.state
@@ -1146,7 +3443,7 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MultiCreateUserV1Response); i {
 			case 0:
 				return &v.state
@@ -1158,7 +3455,19 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateUserV1Request); i {
 			case 0:
 				return &v.state
@@ -1170,7 +3479,7 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UpdateUserV1Response); i {
 			case 0:
 				return &v.state
@@ -1182,7 +3491,175 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUsersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportUsersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportUsersV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateUserV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiUpdateUserV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveUserV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MultiRemoveUserV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserChangeResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserHistoryV1Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserHistoryV1Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserParams); i {
 			case 0:
 				return &v.state
@@ -1194,7 +3671,31 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserSort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserProfile); i {
 			case 0:
 				return &v.state
@@ -1206,7 +3707,7 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_api_ocp_user_api_ocp_user_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -1219,18 +3720,27 @@ func file_api_ocp_user_api_ocp_user_api_proto_init() {
 			}
 		}
 	}
+	file_api_ocp_user_api_ocp_user_api_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*CreateUserResult_UserId)(nil),
+		(*CreateUserResult_Error)(nil),
+	}
+	file_api_ocp_user_api_ocp_user_api_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*UserChangeResult_Version)(nil),
+		(*UserChangeResult_Error)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_ocp_user_api_ocp_user_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   39,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_ocp_user_api_ocp_user_api_proto_goTypes,
 		DependencyIndexes: file_api_ocp_user_api_ocp_user_api_proto_depIdxs,
+		EnumInfos:         file_api_ocp_user_api_ocp_user_api_proto_enumTypes,
 		MessageInfos:      file_api_ocp_user_api_ocp_user_api_proto_msgTypes,
 	}.Build()
 	File_api_ocp_user_api_ocp_user_api_proto = out.File
@@ -11,11 +11,12 @@ import (
 	"net/mail"
 	"net/url"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 	"unicode/utf8"
 
-	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // ensure the imports are used
@@ -30,24 +31,118 @@ var (
 	_ = time.Duration(0)
 	_ = (*url.URL)(nil)
 	_ = (*mail.Address)(nil)
-	_ = ptypes.DynamicAny{}
+	_ = anypb.Any{}
+	_ = sort.Sort
 )
 
 // Validate checks the field values on ListUsersV1Request with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *ListUsersV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersV1RequestMultiError, or nil if none found.
+func (m *ListUsersV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	// no validation rules for Limit
 
-	// no validation rules for Offset
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersV1RequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersV1RequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersV1RequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListUsersV1RequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListUsersV1RequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListUsersV1RequestValidationError{
+				field:  "Sort",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUsersV1RequestMultiError(errors)
+	}
 
 	return nil
 }
 
+// ListUsersV1RequestMultiError is an error wrapping multiple validation errors
+// returned by ListUsersV1Request.ValidateAll() if the designated constraints
+// aren't met.
+type ListUsersV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersV1RequestMultiError) AllErrors() []error { return m }
+
 // ListUsersV1RequestValidationError is the validation error returned by
 // ListUsersV1Request.Validate if the designated constraints aren't met.
 type ListUsersV1RequestValidationError struct {
@@ -106,16 +201,49 @@ var _ interface {
 
 // Validate checks the field values on ListUsersV1Response with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *ListUsersV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUsersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUsersV1ResponseMultiError, or nil if none found.
+func (m *ListUsersV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUsersV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	for idx, item := range m.GetUsers() {
 		_, _ = idx, item
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListUsersV1ResponseValidationError{
 					field:  fmt.Sprintf("Users[%v]", idx),
@@ -127,11 +255,32 @@ func (m *ListUsersV1Response) Validate() error {
 
 	}
 
-	// no validation rules for NextOffset
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUsersV1ResponseMultiError(errors)
+	}
 
 	return nil
 }
 
+// ListUsersV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUsersV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type ListUsersV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUsersV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUsersV1ResponseMultiError) AllErrors() []error { return m }
+
 // ListUsersV1ResponseValidationError is the validation error returned by
 // ListUsersV1Response.Validate if the designated constraints aren't met.
 type ListUsersV1ResponseValidationError struct {
@@ -190,17 +339,79 @@ var _ interface {
 
 // Validate checks the field values on CreateUserV1Request with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *CreateUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserV1RequestMultiError, or nil if none found.
+func (m *CreateUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for CalendarId
+	var errors []error
 
-	// no validation rules for ResumeId
+	if m.GetCalendarId() <= 0 {
+		err := CreateUserV1RequestValidationError{
+			field:  "CalendarId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetResumeId() <= 0 {
+		err := CreateUserV1RequestValidationError{
+			field:  "ResumeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetProfile() == nil {
+		err := CreateUserV1RequestValidationError{
+			field:  "Profile",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserV1RequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserV1RequestValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
 			return CreateUserV1RequestValidationError{
 				field:  "Profile",
@@ -210,9 +421,41 @@ func (m *CreateUserV1Request) Validate() error {
 		}
 	}
 
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		err := CreateUserV1RequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUserV1RequestMultiError(errors)
+	}
+
 	return nil
 }
 
+// CreateUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by CreateUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserV1RequestMultiError) AllErrors() []error { return m }
+
 // CreateUserV1RequestValidationError is the validation error returned by
 // CreateUserV1Request.Validate if the designated constraints aren't met.
 type CreateUserV1RequestValidationError struct {
@@ -271,17 +514,52 @@ var _ interface {
 
 // Validate checks the field values on CreateUserV1Response with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *CreateUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserV1ResponseMultiError, or nil if none found.
+func (m *CreateUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	// no validation rules for UserId
 
+	if len(errors) > 0 {
+		return CreateUserV1ResponseMultiError(errors)
+	}
+
 	return nil
 }
 
+// CreateUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateUserV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type CreateUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserV1ResponseMultiError) AllErrors() []error { return m }
+
 // CreateUserV1ResponseValidationError is the validation error returned by
 // CreateUserV1Response.Validate if the designated constraints aren't met.
 type CreateUserV1ResponseValidationError struct {
@@ -340,17 +618,63 @@ var _ interface {
 
 // Validate checks the field values on RemoveUserV1Request with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *RemoveUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUserV1RequestMultiError, or nil if none found.
+func (m *RemoveUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUserV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RemoveUserV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ExpectedVersion
+
+	if len(errors) > 0 {
+		return RemoveUserV1RequestMultiError(errors)
+	}
 
 	return nil
 }
 
+// RemoveUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUserV1RequestMultiError) AllErrors() []error { return m }
+
 // RemoveUserV1RequestValidationError is the validation error returned by
 // RemoveUserV1Request.Validate if the designated constraints aren't met.
 type RemoveUserV1RequestValidationError struct {
@@ -409,17 +733,52 @@ var _ interface {
 
 // Validate checks the field values on RemoveUserV1Response with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
+// violated, the first error encountered is returned, or nil if there are no violations.
 func (m *RemoveUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUserV1ResponseMultiError, or nil if none found.
+func (m *RemoveUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUserV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
+	var errors []error
+
 	// no validation rules for Deleted
 
+	if len(errors) > 0 {
+		return RemoveUserV1ResponseMultiError(errors)
+	}
+
 	return nil
 }
 
+// RemoveUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveUserV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUserV1ResponseMultiError) AllErrors() []error { return m }
+
 // RemoveUserV1ResponseValidationError is the validation error returned by
 // RemoveUserV1Response.Validate if the designated constraints aren't met.
 type RemoveUserV1ResponseValidationError struct {
@@ -476,22 +835,66 @@ var _ interface {
 	ErrorName() string
 } = RemoveUserV1ResponseValidationError{}
 
-// Validate checks the field values on DescribeUserV1Request with the rules
+// Validate checks the field values on RestoreUserV1Request with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DescribeUserV1Request) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserV1RequestMultiError, or nil if none found.
+func (m *RestoreUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RestoreUserV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreUserV1RequestMultiError(errors)
+	}
 
 	return nil
 }
 
-// DescribeUserV1RequestValidationError is the validation error returned by
-// DescribeUserV1Request.Validate if the designated constraints aren't met.
-type DescribeUserV1RequestValidationError struct {
+// RestoreUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserV1RequestMultiError) AllErrors() []error { return m }
+
+// RestoreUserV1RequestValidationError is the validation error returned by
+// RestoreUserV1Request.Validate if the designated constraints aren't met.
+type RestoreUserV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -499,24 +902,24 @@ type DescribeUserV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeUserV1RequestValidationError) Field() string { return e.field }
+func (e RestoreUserV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeUserV1RequestValidationError) Reason() string { return e.reason }
+func (e RestoreUserV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeUserV1RequestValidationError) Cause() error { return e.cause }
+func (e RestoreUserV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeUserV1RequestValidationError) Key() bool { return e.key }
+func (e RestoreUserV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeUserV1RequestValidationError) ErrorName() string {
-	return "DescribeUserV1RequestValidationError"
+func (e RestoreUserV1RequestValidationError) ErrorName() string {
+	return "RestoreUserV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeUserV1RequestValidationError) Error() string {
+func (e RestoreUserV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -528,14 +931,14 @@ func (e DescribeUserV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeUserV1Request.%s: %s%s",
+		"invalid %sRestoreUserV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeUserV1RequestValidationError{}
+var _ error = RestoreUserV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -543,32 +946,59 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeUserV1RequestValidationError{}
+} = RestoreUserV1RequestValidationError{}
 
-// Validate checks the field values on DescribeUserV1Response with the rules
+// Validate checks the field values on RestoreUserV1Response with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *DescribeUserV1Response) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreUserV1ResponseMultiError, or nil if none found.
+func (m *RestoreUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreUserV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DescribeUserV1ResponseValidationError{
-				field:  "User",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	var errors []error
+
+	// no validation rules for Restored
+
+	if len(errors) > 0 {
+		return RestoreUserV1ResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DescribeUserV1ResponseValidationError is the validation error returned by
-// DescribeUserV1Response.Validate if the designated constraints aren't met.
-type DescribeUserV1ResponseValidationError struct {
+// RestoreUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreUserV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreUserV1ResponseMultiError) AllErrors() []error { return m }
+
+// RestoreUserV1ResponseValidationError is the validation error returned by
+// RestoreUserV1Response.Validate if the designated constraints aren't met.
+type RestoreUserV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -576,24 +1006,24 @@ type DescribeUserV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DescribeUserV1ResponseValidationError) Field() string { return e.field }
+func (e RestoreUserV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DescribeUserV1ResponseValidationError) Reason() string { return e.reason }
+func (e RestoreUserV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DescribeUserV1ResponseValidationError) Cause() error { return e.cause }
+func (e RestoreUserV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DescribeUserV1ResponseValidationError) Key() bool { return e.key }
+func (e RestoreUserV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DescribeUserV1ResponseValidationError) ErrorName() string {
-	return "DescribeUserV1ResponseValidationError"
+func (e RestoreUserV1ResponseValidationError) ErrorName() string {
+	return "RestoreUserV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DescribeUserV1ResponseValidationError) Error() string {
+func (e RestoreUserV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -605,14 +1035,14 @@ func (e DescribeUserV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDescribeUserV1Response.%s: %s%s",
+		"invalid %sRestoreUserV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DescribeUserV1ResponseValidationError{}
+var _ error = RestoreUserV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -620,37 +1050,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DescribeUserV1ResponseValidationError{}
+} = RestoreUserV1ResponseValidationError{}
 
-// Validate checks the field values on MultiCreateUserV1Request with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MultiCreateUserV1Request) Validate() error {
+// Validate checks the field values on PurgeDeletedUsersV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeDeletedUsersV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeDeletedUsersV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeDeletedUsersV1RequestMultiError, or nil if none found.
+func (m *PurgeDeletedUsersV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeDeletedUsersV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetUsers() {
-		_, _ = idx, item
+	var errors []error
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return MultiCreateUserV1RequestValidationError{
-					field:  fmt.Sprintf("Users[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if m.GetDeletedBefore() == nil {
+		err := PurgeDeletedUsersV1RequestValidationError{
+			field:  "DeletedBefore",
+			reason: "value is required",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
+	if len(errors) > 0 {
+		return PurgeDeletedUsersV1RequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// MultiCreateUserV1RequestValidationError is the validation error returned by
-// MultiCreateUserV1Request.Validate if the designated constraints aren't met.
-type MultiCreateUserV1RequestValidationError struct {
+// PurgeDeletedUsersV1RequestMultiError is an error wrapping multiple
+// validation errors returned by PurgeDeletedUsersV1Request.ValidateAll() if
+// the designated constraints aren't met.
+type PurgeDeletedUsersV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeDeletedUsersV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeDeletedUsersV1RequestMultiError) AllErrors() []error { return m }
+
+// PurgeDeletedUsersV1RequestValidationError is the validation error returned
+// by PurgeDeletedUsersV1Request.Validate if the designated constraints aren't met.
+type PurgeDeletedUsersV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -658,24 +1119,24 @@ type MultiCreateUserV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateUserV1RequestValidationError) Field() string { return e.field }
+func (e PurgeDeletedUsersV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateUserV1RequestValidationError) Reason() string { return e.reason }
+func (e PurgeDeletedUsersV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateUserV1RequestValidationError) Cause() error { return e.cause }
+func (e PurgeDeletedUsersV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateUserV1RequestValidationError) Key() bool { return e.key }
+func (e PurgeDeletedUsersV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateUserV1RequestValidationError) ErrorName() string {
-	return "MultiCreateUserV1RequestValidationError"
+func (e PurgeDeletedUsersV1RequestValidationError) ErrorName() string {
+	return "PurgeDeletedUsersV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateUserV1RequestValidationError) Error() string {
+func (e PurgeDeletedUsersV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -687,14 +1148,14 @@ func (e MultiCreateUserV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateUserV1Request.%s: %s%s",
+		"invalid %sPurgeDeletedUsersV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateUserV1RequestValidationError{}
+var _ error = PurgeDeletedUsersV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -702,24 +1163,60 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateUserV1RequestValidationError{}
+} = PurgeDeletedUsersV1RequestValidationError{}
 
-// Validate checks the field values on MultiCreateUserV1Response with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *MultiCreateUserV1Response) Validate() error {
+// Validate checks the field values on PurgeDeletedUsersV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PurgeDeletedUsersV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurgeDeletedUsersV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurgeDeletedUsersV1ResponseMultiError, or nil if none found.
+func (m *PurgeDeletedUsersV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurgeDeletedUsersV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Count
+	var errors []error
+
+	// no validation rules for Purged
+
+	if len(errors) > 0 {
+		return PurgeDeletedUsersV1ResponseMultiError(errors)
+	}
 
 	return nil
 }
 
-// MultiCreateUserV1ResponseValidationError is the validation error returned by
-// MultiCreateUserV1Response.Validate if the designated constraints aren't met.
-type MultiCreateUserV1ResponseValidationError struct {
+// PurgeDeletedUsersV1ResponseMultiError is an error wrapping multiple
+// validation errors returned by PurgeDeletedUsersV1Response.ValidateAll() if
+// the designated constraints aren't met.
+type PurgeDeletedUsersV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurgeDeletedUsersV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurgeDeletedUsersV1ResponseMultiError) AllErrors() []error { return m }
+
+// PurgeDeletedUsersV1ResponseValidationError is the validation error returned
+// by PurgeDeletedUsersV1Response.Validate if the designated constraints
+// aren't met.
+type PurgeDeletedUsersV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -727,24 +1224,24 @@ type MultiCreateUserV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e MultiCreateUserV1ResponseValidationError) Field() string { return e.field }
+func (e PurgeDeletedUsersV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MultiCreateUserV1ResponseValidationError) Reason() string { return e.reason }
+func (e PurgeDeletedUsersV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MultiCreateUserV1ResponseValidationError) Cause() error { return e.cause }
+func (e PurgeDeletedUsersV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MultiCreateUserV1ResponseValidationError) Key() bool { return e.key }
+func (e PurgeDeletedUsersV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MultiCreateUserV1ResponseValidationError) ErrorName() string {
-	return "MultiCreateUserV1ResponseValidationError"
+func (e PurgeDeletedUsersV1ResponseValidationError) ErrorName() string {
+	return "PurgeDeletedUsersV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e MultiCreateUserV1ResponseValidationError) Error() string {
+func (e PurgeDeletedUsersV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -756,14 +1253,14 @@ func (e MultiCreateUserV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMultiCreateUserV1Response.%s: %s%s",
+		"invalid %sPurgeDeletedUsersV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MultiCreateUserV1ResponseValidationError{}
+var _ error = PurgeDeletedUsersV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -771,34 +1268,97 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MultiCreateUserV1ResponseValidationError{}
+} = PurgeDeletedUsersV1ResponseValidationError{}
 
-// Validate checks the field values on UpdateUserV1Request with the rules
+// Validate checks the field values on DescribeUserV1Request with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpdateUserV1Request) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeUserV1RequestMultiError, or nil if none found.
+func (m *DescribeUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeUserV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for UserId
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := DescribeUserV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if v, ok := interface{}(m.GetUserParams()).(interface{ Validate() error }); ok {
+	if all {
+		switch v := interface{}(m.GetAsOf()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeUserV1RequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeUserV1RequestValidationError{
+					field:  "AsOf",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAsOf()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return UpdateUserV1RequestValidationError{
-				field:  "UserParams",
+			return DescribeUserV1RequestValidationError{
+				field:  "AsOf",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return DescribeUserV1RequestMultiError(errors)
+	}
+
 	return nil
 }
 
-// UpdateUserV1RequestValidationError is the validation error returned by
-// UpdateUserV1Request.Validate if the designated constraints aren't met.
-type UpdateUserV1RequestValidationError struct {
+// DescribeUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeUserV1RequestMultiError) AllErrors() []error { return m }
+
+// DescribeUserV1RequestValidationError is the validation error returned by
+// DescribeUserV1Request.Validate if the designated constraints aren't met.
+type DescribeUserV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -806,24 +1366,24 @@ type UpdateUserV1RequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserV1RequestValidationError) Field() string { return e.field }
+func (e DescribeUserV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserV1RequestValidationError) Reason() string { return e.reason }
+func (e DescribeUserV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserV1RequestValidationError) Cause() error { return e.cause }
+func (e DescribeUserV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserV1RequestValidationError) Key() bool { return e.key }
+func (e DescribeUserV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserV1RequestValidationError) ErrorName() string {
-	return "UpdateUserV1RequestValidationError"
+func (e DescribeUserV1RequestValidationError) ErrorName() string {
+	return "DescribeUserV1RequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserV1RequestValidationError) Error() string {
+func (e DescribeUserV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -835,14 +1395,14 @@ func (e UpdateUserV1RequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserV1Request.%s: %s%s",
+		"invalid %sDescribeUserV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserV1RequestValidationError{}
+var _ error = DescribeUserV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -850,24 +1410,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserV1RequestValidationError{}
+} = DescribeUserV1RequestValidationError{}
 
-// Validate checks the field values on UpdateUserV1Response with the rules
+// Validate checks the field values on DescribeUserV1Response with the rules
 // defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *UpdateUserV1Response) Validate() error {
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeUserV1ResponseMultiError, or nil if none found.
+func (m *DescribeUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeUserV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Updated
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeUserV1ResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeUserV1ResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeUserV1ResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeUserV1ResponseMultiError(errors)
+	}
 
 	return nil
 }
 
-// UpdateUserV1ResponseValidationError is the validation error returned by
-// UpdateUserV1Response.Validate if the designated constraints aren't met.
-type UpdateUserV1ResponseValidationError struct {
+// DescribeUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeUserV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeUserV1ResponseMultiError) AllErrors() []error { return m }
+
+// DescribeUserV1ResponseValidationError is the validation error returned by
+// DescribeUserV1Response.Validate if the designated constraints aren't met.
+type DescribeUserV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -875,24 +1497,24 @@ type UpdateUserV1ResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e UpdateUserV1ResponseValidationError) Field() string { return e.field }
+func (e DescribeUserV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UpdateUserV1ResponseValidationError) Reason() string { return e.reason }
+func (e DescribeUserV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UpdateUserV1ResponseValidationError) Cause() error { return e.cause }
+func (e DescribeUserV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UpdateUserV1ResponseValidationError) Key() bool { return e.key }
+func (e DescribeUserV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UpdateUserV1ResponseValidationError) ErrorName() string {
-	return "UpdateUserV1ResponseValidationError"
+func (e DescribeUserV1ResponseValidationError) ErrorName() string {
+	return "DescribeUserV1ResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e UpdateUserV1ResponseValidationError) Error() string {
+func (e DescribeUserV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -904,14 +1526,14 @@ func (e UpdateUserV1ResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUpdateUserV1Response.%s: %s%s",
+		"invalid %sDescribeUserV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UpdateUserV1ResponseValidationError{}
+var _ error = DescribeUserV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -919,35 +1541,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UpdateUserV1ResponseValidationError{}
+} = DescribeUserV1ResponseValidationError{}
 
-// Validate checks the field values on UserParams with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *UserParams) Validate() error {
+// Validate checks the field values on BatchDescribeUsersV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDescribeUsersV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDescribeUsersV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDescribeUsersV1RequestMultiError, or nil if none found.
+func (m *BatchDescribeUsersV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDescribeUsersV1Request) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for CalendarId
+	var errors []error
 
-	// no validation rules for ResumeId
+	if l := len(m.GetUserIds()); l < 1 || l > 1000 {
+		err := BatchDescribeUsersV1RequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserParamsValidationError{
-				field:  "Profile",
-				reason: "embedded message failed validation",
-				cause:  err,
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := BatchDescribeUsersV1RequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
 			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDescribeUsersV1RequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// UserParamsValidationError is the validation error returned by
-// UserParams.Validate if the designated constraints aren't met.
-type UserParamsValidationError struct {
+// BatchDescribeUsersV1RequestMultiError is an error wrapping multiple
+// validation errors returned by BatchDescribeUsersV1Request.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDescribeUsersV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDescribeUsersV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDescribeUsersV1RequestMultiError) AllErrors() []error { return m }
+
+// BatchDescribeUsersV1RequestValidationError is the validation error returned
+// by BatchDescribeUsersV1Request.Validate if the designated constraints
+// aren't met.
+type BatchDescribeUsersV1RequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -955,22 +1627,24 @@ type UserParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserParamsValidationError) Field() string { return e.field }
+func (e BatchDescribeUsersV1RequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserParamsValidationError) Reason() string { return e.reason }
+func (e BatchDescribeUsersV1RequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserParamsValidationError) Cause() error { return e.cause }
+func (e BatchDescribeUsersV1RequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserParamsValidationError) Key() bool { return e.key }
+func (e BatchDescribeUsersV1RequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserParamsValidationError) ErrorName() string { return "UserParamsValidationError" }
+func (e BatchDescribeUsersV1RequestValidationError) ErrorName() string {
+	return "BatchDescribeUsersV1RequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserParamsValidationError) Error() string {
+func (e BatchDescribeUsersV1RequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -982,14 +1656,14 @@ func (e UserParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserParams.%s: %s%s",
+		"invalid %sBatchDescribeUsersV1Request.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserParamsValidationError{}
+var _ error = BatchDescribeUsersV1RequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -997,30 +1671,126 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserParamsValidationError{}
+} = BatchDescribeUsersV1RequestValidationError{}
 
-// Validate checks the field values on UserProfile with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *UserProfile) Validate() error {
+// Validate checks the field values on BatchDescribeUsersV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchDescribeUsersV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDescribeUsersV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDescribeUsersV1ResponseMultiError, or nil if none found.
+func (m *BatchDescribeUsersV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDescribeUsersV1Response) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Name
+	var errors []error
 
-	// no validation rules for Surname
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
 
-	// no validation rules for Patronymic
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDescribeUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDescribeUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDescribeUsersV1ResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Email
+	}
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDescribeUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDescribeUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDescribeUsersV1ResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDescribeUsersV1ResponseMultiError(errors)
+	}
 
 	return nil
 }
 
-// UserProfileValidationError is the validation error returned by
-// UserProfile.Validate if the designated constraints aren't met.
-type UserProfileValidationError struct {
+// BatchDescribeUsersV1ResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchDescribeUsersV1Response.ValidateAll() if
+// the designated constraints aren't met.
+type BatchDescribeUsersV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDescribeUsersV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDescribeUsersV1ResponseMultiError) AllErrors() []error { return m }
+
+// BatchDescribeUsersV1ResponseValidationError is the validation error returned
+// by BatchDescribeUsersV1Response.Validate if the designated constraints
+// aren't met.
+type BatchDescribeUsersV1ResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1028,22 +1798,24 @@ type UserProfileValidationError struct {
 }
 
 // Field function returns field value.
-func (e UserProfileValidationError) Field() string { return e.field }
+func (e BatchDescribeUsersV1ResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e UserProfileValidationError) Reason() string { return e.reason }
+func (e BatchDescribeUsersV1ResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e UserProfileValidationError) Cause() error { return e.cause }
+func (e BatchDescribeUsersV1ResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e UserProfileValidationError) Key() bool { return e.key }
+func (e BatchDescribeUsersV1ResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e UserProfileValidationError) ErrorName() string { return "UserProfileValidationError" }
+func (e BatchDescribeUsersV1ResponseValidationError) ErrorName() string {
+	return "BatchDescribeUsersV1ResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e UserProfileValidationError) Error() string {
+func (e BatchDescribeUsersV1ResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1055,14 +1827,14 @@ func (e UserProfileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sUserProfile.%s: %s%s",
+		"invalid %sBatchDescribeUsersV1Response.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = UserProfileValidationError{}
+var _ error = BatchDescribeUsersV1ResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1070,34 +1842,3686 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = UserProfileValidationError{}
+} = BatchDescribeUsersV1ResponseValidationError{}
 
-// Validate checks the field values on User with the rules defined in the proto
-// definition for this message. If any rules are violated, an error is returned.
-func (m *User) Validate() error {
+// Validate checks the field values on UserError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserErrorMultiError, or nil
+// if none found.
+func (m *UserError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for Id
+	var errors []error
 
-	// no validation rules for CalendarId
+	// no validation rules for UserId
 
-	// no validation rules for ResumeId
+	// no validation rules for Reason
 
-	if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return UserValidationError{
-				field:  "Profile",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return UserErrorMultiError(errors)
 	}
 
 	return nil
 }
 
+// UserErrorMultiError is an error wrapping multiple validation errors returned
+// by UserError.ValidateAll() if the designated constraints aren't met.
+type UserErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserErrorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserErrorMultiError) AllErrors() []error { return m }
+
+// UserErrorValidationError is the validation error returned by
+// UserError.Validate if the designated constraints aren't met.
+type UserErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserErrorValidationError) ErrorName() string { return "UserErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserErrorValidationError{}
+
+// Validate checks the field values on MultiCreateUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MultiCreateUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiCreateUserV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiCreateUserV1RequestMultiError, or nil if none found.
+func (m *MultiCreateUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiCreateUserV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUsers()); l < 1 || l > 1000 {
+		err := MultiCreateUserV1RequestValidationError{
+			field:  "Users",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MultiCreateUserV1RequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MultiCreateUserV1RequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateUserV1RequestValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if utf8.RuneCountInString(m.GetIdempotencyKey()) > 128 {
+		err := MultiCreateUserV1RequestValidationError{
+			field:  "IdempotencyKey",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Atomic
+
+	if len(errors) > 0 {
+		return MultiCreateUserV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiCreateUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by MultiCreateUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type MultiCreateUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiCreateUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiCreateUserV1RequestMultiError) AllErrors() []error { return m }
+
+// MultiCreateUserV1RequestValidationError is the validation error returned by
+// MultiCreateUserV1Request.Validate if the designated constraints aren't met.
+type MultiCreateUserV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateUserV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateUserV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateUserV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateUserV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateUserV1RequestValidationError) ErrorName() string {
+	return "MultiCreateUserV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateUserV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateUserV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateUserV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateUserV1RequestValidationError{}
+
+// Validate checks the field values on MultiCreateUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MultiCreateUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiCreateUserV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiCreateUserV1ResponseMultiError, or nil if none found.
+func (m *MultiCreateUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiCreateUserV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MultiCreateUserV1ResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MultiCreateUserV1ResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiCreateUserV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MultiCreateUserV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiCreateUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by MultiCreateUserV1Response.ValidateAll() if the
+// designated constraints aren't met.
+type MultiCreateUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiCreateUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiCreateUserV1ResponseMultiError) AllErrors() []error { return m }
+
+// MultiCreateUserV1ResponseValidationError is the validation error returned by
+// MultiCreateUserV1Response.Validate if the designated constraints aren't met.
+type MultiCreateUserV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiCreateUserV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiCreateUserV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiCreateUserV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiCreateUserV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiCreateUserV1ResponseValidationError) ErrorName() string {
+	return "MultiCreateUserV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiCreateUserV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiCreateUserV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiCreateUserV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiCreateUserV1ResponseValidationError{}
+
+// Validate checks the field values on CreateUserResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserResultMultiError, or nil if none found.
+func (m *CreateUserResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Index
+
+	switch v := m.Result.(type) {
+	case *CreateUserResult_UserId:
+		if v == nil {
+			err := CreateUserResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for UserId
+	case *CreateUserResult_Error:
+		if v == nil {
+			err := CreateUserResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateUserResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateUserResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateUserResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CreateUserResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserResultMultiError is an error wrapping multiple validation errors
+// returned by CreateUserResult.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserResultMultiError) AllErrors() []error { return m }
+
+// CreateUserResultValidationError is the validation error returned by
+// CreateUserResult.Validate if the designated constraints aren't met.
+type CreateUserResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserResultValidationError) ErrorName() string { return "CreateUserResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateUserResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserResultValidationError{}
+
+// Validate checks the field values on UpdateUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserV1RequestMultiError, or nil if none found.
+func (m *UpdateUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UpdateUserV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserParams() == nil {
+		err := UpdateUserV1RequestValidationError{
+			field:  "UserParams",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUserParams()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserV1RequestValidationError{
+					field:  "UserParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserV1RequestValidationError{
+					field:  "UserParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserParams()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserV1RequestValidationError{
+				field:  "UserParams",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserV1RequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserV1RequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserV1RequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedVersion
+
+	if len(errors) > 0 {
+		return UpdateUserV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserV1RequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserV1RequestValidationError is the validation error returned by
+// UpdateUserV1Request.Validate if the designated constraints aren't met.
+type UpdateUserV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserV1RequestValidationError) ErrorName() string {
+	return "UpdateUserV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserV1RequestValidationError{}
+
+// Validate checks the field values on UpdateUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserV1ResponseMultiError, or nil if none found.
+func (m *UpdateUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Updated
+
+	// no validation rules for Version
+
+	if len(errors) > 0 {
+		return UpdateUserV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateUserV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserV1ResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUserV1ResponseValidationError is the validation error returned by
+// UpdateUserV1Response.Validate if the designated constraints aren't met.
+type UpdateUserV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserV1ResponseValidationError) ErrorName() string {
+	return "UpdateUserV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserV1ResponseValidationError{}
+
+// Validate checks the field values on ExportUsersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUsersV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUsersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUsersV1RequestMultiError, or nil if none found.
+func (m *ExportUsersV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUsersV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportUsersV1RequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportUsersV1RequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportUsersV1RequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSort()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportUsersV1RequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportUsersV1RequestValidationError{
+					field:  "Sort",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSort()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportUsersV1RequestValidationError{
+				field:  "Sort",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExportUsersV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUsersV1RequestMultiError is an error wrapping multiple validation
+// errors returned by ExportUsersV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type ExportUsersV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUsersV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUsersV1RequestMultiError) AllErrors() []error { return m }
+
+// ExportUsersV1RequestValidationError is the validation error returned by
+// ExportUsersV1Request.Validate if the designated constraints aren't met.
+type ExportUsersV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUsersV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUsersV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUsersV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUsersV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUsersV1RequestValidationError) ErrorName() string {
+	return "ExportUsersV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUsersV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUsersV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUsersV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUsersV1RequestValidationError{}
+
+// Validate checks the field values on ExportUsersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportUsersV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportUsersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportUsersV1ResponseMultiError, or nil if none found.
+func (m *ExportUsersV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportUsersV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportUsersV1ResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportUsersV1ResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExportUsersV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportUsersV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportUsersV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type ExportUsersV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportUsersV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportUsersV1ResponseMultiError) AllErrors() []error { return m }
+
+// ExportUsersV1ResponseValidationError is the validation error returned by
+// ExportUsersV1Response.Validate if the designated constraints aren't met.
+type ExportUsersV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportUsersV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportUsersV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportUsersV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportUsersV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportUsersV1ResponseValidationError) ErrorName() string {
+	return "ExportUsersV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportUsersV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportUsersV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportUsersV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportUsersV1ResponseValidationError{}
+
+// Validate checks the field values on ImportUsersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportUsersV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportUsersV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportUsersV1RequestMultiError, or nil if none found.
+func (m *ImportUsersV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportUsersV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetUsers()) > 1000 {
+		err := ImportUsersV1RequestValidationError{
+			field:  "Users",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportUsersV1RequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportUsersV1RequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportUsersV1RequestValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportUsersV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportUsersV1RequestMultiError is an error wrapping multiple validation
+// errors returned by ImportUsersV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type ImportUsersV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportUsersV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportUsersV1RequestMultiError) AllErrors() []error { return m }
+
+// ImportUsersV1RequestValidationError is the validation error returned by
+// ImportUsersV1Request.Validate if the designated constraints aren't met.
+type ImportUsersV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportUsersV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportUsersV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportUsersV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportUsersV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportUsersV1RequestValidationError) ErrorName() string {
+	return "ImportUsersV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportUsersV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportUsersV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportUsersV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportUsersV1RequestValidationError{}
+
+// Validate checks the field values on ImportUsersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ImportUsersV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportUsersV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportUsersV1ResponseMultiError, or nil if none found.
+func (m *ImportUsersV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportUsersV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Received
+
+	// no validation rules for Created
+
+	// no validation rules for Failed
+
+	// no validation rules for Done
+
+	if len(errors) > 0 {
+		return ImportUsersV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportUsersV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by ImportUsersV1Response.ValidateAll() if the designated
+// constraints aren't met.
+type ImportUsersV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportUsersV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportUsersV1ResponseMultiError) AllErrors() []error { return m }
+
+// ImportUsersV1ResponseValidationError is the validation error returned by
+// ImportUsersV1Response.Validate if the designated constraints aren't met.
+type ImportUsersV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportUsersV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportUsersV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportUsersV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportUsersV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportUsersV1ResponseValidationError) ErrorName() string {
+	return "ImportUsersV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportUsersV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportUsersV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportUsersV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportUsersV1ResponseValidationError{}
+
+// Validate checks the field values on MultiUpdateUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MultiUpdateUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiUpdateUserV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiUpdateUserV1RequestMultiError, or nil if none found.
+func (m *MultiUpdateUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiUpdateUserV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUsers()); l < 1 || l > 1000 {
+		err := MultiUpdateUserV1RequestValidationError{
+			field:  "Users",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MultiUpdateUserV1RequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MultiUpdateUserV1RequestValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateUserV1RequestValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MultiUpdateUserV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiUpdateUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by MultiUpdateUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type MultiUpdateUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiUpdateUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiUpdateUserV1RequestMultiError) AllErrors() []error { return m }
+
+// MultiUpdateUserV1RequestValidationError is the validation error returned by
+// MultiUpdateUserV1Request.Validate if the designated constraints aren't met.
+type MultiUpdateUserV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateUserV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateUserV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateUserV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateUserV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateUserV1RequestValidationError) ErrorName() string {
+	return "MultiUpdateUserV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateUserV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateUserV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateUserV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateUserV1RequestValidationError{}
+
+// Validate checks the field values on UserUpdate with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserUpdate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserUpdate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserUpdateMultiError, or
+// nil if none found.
+func (m *UserUpdate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserUpdate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := UserUpdateValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUserParams() == nil {
+		err := UserUpdateValidationError{
+			field:  "UserParams",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUserParams()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdateValidationError{
+					field:  "UserParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdateValidationError{
+					field:  "UserParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserParams()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdateValidationError{
+				field:  "UserParams",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserUpdateValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserUpdateValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserUpdateValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ExpectedVersion
+
+	if len(errors) > 0 {
+		return UserUpdateMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserUpdateMultiError is an error wrapping multiple validation errors
+// returned by UserUpdate.ValidateAll() if the designated constraints aren't met.
+type UserUpdateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserUpdateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserUpdateMultiError) AllErrors() []error { return m }
+
+// UserUpdateValidationError is the validation error returned by
+// UserUpdate.Validate if the designated constraints aren't met.
+type UserUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserUpdateValidationError) ErrorName() string { return "UserUpdateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserUpdateValidationError{}
+
+// Validate checks the field values on MultiUpdateUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MultiUpdateUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiUpdateUserV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiUpdateUserV1ResponseMultiError, or nil if none found.
+func (m *MultiUpdateUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiUpdateUserV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MultiUpdateUserV1ResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MultiUpdateUserV1ResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiUpdateUserV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MultiUpdateUserV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiUpdateUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by MultiUpdateUserV1Response.ValidateAll() if the
+// designated constraints aren't met.
+type MultiUpdateUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiUpdateUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiUpdateUserV1ResponseMultiError) AllErrors() []error { return m }
+
+// MultiUpdateUserV1ResponseValidationError is the validation error returned by
+// MultiUpdateUserV1Response.Validate if the designated constraints aren't met.
+type MultiUpdateUserV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiUpdateUserV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiUpdateUserV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiUpdateUserV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiUpdateUserV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiUpdateUserV1ResponseValidationError) ErrorName() string {
+	return "MultiUpdateUserV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiUpdateUserV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiUpdateUserV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiUpdateUserV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiUpdateUserV1ResponseValidationError{}
+
+// Validate checks the field values on MultiRemoveUserV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MultiRemoveUserV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiRemoveUserV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiRemoveUserV1RequestMultiError, or nil if none found.
+func (m *MultiRemoveUserV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiRemoveUserV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIds()); l < 1 || l > 1000 {
+		err := MultiRemoveUserV1RequestValidationError{
+			field:  "UserIds",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_MultiRemoveUserV1Request_UserIds_Unique := make(map[uint64]struct{}, len(m.GetUserIds()))
+
+	for idx, item := range m.GetUserIds() {
+		_, _ = idx, item
+
+		if _, exists := _MultiRemoveUserV1Request_UserIds_Unique[item]; exists {
+			err := MultiRemoveUserV1RequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_MultiRemoveUserV1Request_UserIds_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := MultiRemoveUserV1RequestValidationError{
+				field:  fmt.Sprintf("UserIds[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MultiRemoveUserV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiRemoveUserV1RequestMultiError is an error wrapping multiple validation
+// errors returned by MultiRemoveUserV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type MultiRemoveUserV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiRemoveUserV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiRemoveUserV1RequestMultiError) AllErrors() []error { return m }
+
+// MultiRemoveUserV1RequestValidationError is the validation error returned by
+// MultiRemoveUserV1Request.Validate if the designated constraints aren't met.
+type MultiRemoveUserV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveUserV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveUserV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveUserV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveUserV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveUserV1RequestValidationError) ErrorName() string {
+	return "MultiRemoveUserV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveUserV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveUserV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveUserV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveUserV1RequestValidationError{}
+
+// Validate checks the field values on MultiRemoveUserV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MultiRemoveUserV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MultiRemoveUserV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MultiRemoveUserV1ResponseMultiError, or nil if none found.
+func (m *MultiRemoveUserV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MultiRemoveUserV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Count
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MultiRemoveUserV1ResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MultiRemoveUserV1ResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MultiRemoveUserV1ResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MultiRemoveUserV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// MultiRemoveUserV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by MultiRemoveUserV1Response.ValidateAll() if the
+// designated constraints aren't met.
+type MultiRemoveUserV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MultiRemoveUserV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiRemoveUserV1ResponseMultiError) AllErrors() []error { return m }
+
+// MultiRemoveUserV1ResponseValidationError is the validation error returned by
+// MultiRemoveUserV1Response.Validate if the designated constraints aren't met.
+type MultiRemoveUserV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MultiRemoveUserV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MultiRemoveUserV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MultiRemoveUserV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MultiRemoveUserV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MultiRemoveUserV1ResponseValidationError) ErrorName() string {
+	return "MultiRemoveUserV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MultiRemoveUserV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMultiRemoveUserV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MultiRemoveUserV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MultiRemoveUserV1ResponseValidationError{}
+
+// Validate checks the field values on UserChangeResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserChangeResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserChangeResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserChangeResultMultiError, or nil if none found.
+func (m *UserChangeResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserChangeResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	switch v := m.Result.(type) {
+	case *UserChangeResult_Version:
+		if v == nil {
+			err := UserChangeResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Version
+	case *UserChangeResult_Error:
+		if v == nil {
+			err := UserChangeResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserChangeResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserChangeResultValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserChangeResultValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return UserChangeResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserChangeResultMultiError is an error wrapping multiple validation errors
+// returned by UserChangeResult.ValidateAll() if the designated constraints
+// aren't met.
+type UserChangeResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserChangeResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserChangeResultMultiError) AllErrors() []error { return m }
+
+// UserChangeResultValidationError is the validation error returned by
+// UserChangeResult.Validate if the designated constraints aren't met.
+type UserChangeResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserChangeResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserChangeResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserChangeResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserChangeResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserChangeResultValidationError) ErrorName() string { return "UserChangeResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserChangeResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserChangeResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserChangeResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserChangeResultValidationError{}
+
+// Validate checks the field values on ListUserHistoryV1Request with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserHistoryV1Request) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserHistoryV1Request with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserHistoryV1RequestMultiError, or nil if none found.
+func (m *ListUserHistoryV1Request) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserHistoryV1Request) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ListUserHistoryV1RequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 1000 {
+		err := ListUserHistoryV1RequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListUserHistoryV1RequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserHistoryV1RequestMultiError is an error wrapping multiple validation
+// errors returned by ListUserHistoryV1Request.ValidateAll() if the designated
+// constraints aren't met.
+type ListUserHistoryV1RequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserHistoryV1RequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserHistoryV1RequestMultiError) AllErrors() []error { return m }
+
+// ListUserHistoryV1RequestValidationError is the validation error returned by
+// ListUserHistoryV1Request.Validate if the designated constraints aren't met.
+type ListUserHistoryV1RequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserHistoryV1RequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserHistoryV1RequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserHistoryV1RequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserHistoryV1RequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserHistoryV1RequestValidationError) ErrorName() string {
+	return "ListUserHistoryV1RequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserHistoryV1RequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserHistoryV1Request.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserHistoryV1RequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserHistoryV1RequestValidationError{}
+
+// Validate checks the field values on ListUserHistoryV1Response with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListUserHistoryV1Response) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListUserHistoryV1Response with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListUserHistoryV1ResponseMultiError, or nil if none found.
+func (m *ListUserHistoryV1Response) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListUserHistoryV1Response) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListUserHistoryV1ResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListUserHistoryV1ResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListUserHistoryV1ResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListUserHistoryV1ResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListUserHistoryV1ResponseMultiError is an error wrapping multiple validation
+// errors returned by ListUserHistoryV1Response.ValidateAll() if the
+// designated constraints aren't met.
+type ListUserHistoryV1ResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListUserHistoryV1ResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListUserHistoryV1ResponseMultiError) AllErrors() []error { return m }
+
+// ListUserHistoryV1ResponseValidationError is the validation error returned by
+// ListUserHistoryV1Response.Validate if the designated constraints aren't met.
+type ListUserHistoryV1ResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListUserHistoryV1ResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListUserHistoryV1ResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListUserHistoryV1ResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListUserHistoryV1ResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListUserHistoryV1ResponseValidationError) ErrorName() string {
+	return "ListUserHistoryV1ResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListUserHistoryV1ResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListUserHistoryV1Response.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListUserHistoryV1ResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListUserHistoryV1ResponseValidationError{}
+
+// Validate checks the field values on UserHistoryEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserHistoryEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserHistoryEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserHistoryEntryMultiError, or nil if none found.
+func (m *UserHistoryEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserHistoryEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for Operation
+
+	// no validation rules for Actor
+
+	// no validation rules for TraceId
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserHistoryEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserHistoryEntryValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserHistoryEntryValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserHistoryEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserHistoryEntryValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserHistoryEntryValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserHistoryEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserHistoryEntryMultiError is an error wrapping multiple validation errors
+// returned by UserHistoryEntry.ValidateAll() if the designated constraints
+// aren't met.
+type UserHistoryEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserHistoryEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserHistoryEntryMultiError) AllErrors() []error { return m }
+
+// UserHistoryEntryValidationError is the validation error returned by
+// UserHistoryEntry.Validate if the designated constraints aren't met.
+type UserHistoryEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserHistoryEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserHistoryEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserHistoryEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserHistoryEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserHistoryEntryValidationError) ErrorName() string { return "UserHistoryEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserHistoryEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserHistoryEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserHistoryEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserHistoryEntryValidationError{}
+
+// Validate checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FieldChangeMultiError, or
+// nil if none found.
+func (m *FieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Field
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldChangeValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FieldChangeValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FieldChangeValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// FieldChangeMultiError is an error wrapping multiple validation errors
+// returned by FieldChange.ValidateAll() if the designated constraints aren't met.
+type FieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FieldChangeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FieldChangeMultiError) AllErrors() []error { return m }
+
+// FieldChangeValidationError is the validation error returned by
+// FieldChange.Validate if the designated constraints aren't met.
+type FieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FieldChangeValidationError) ErrorName() string { return "FieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FieldChangeValidationError{}
+
+// Validate checks the field values on UserParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserParams with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserParamsMultiError, or
+// nil if none found.
+func (m *UserParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCalendarId() <= 0 {
+		err := UserParamsValidationError{
+			field:  "CalendarId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetResumeId() <= 0 {
+		err := UserParamsValidationError{
+			field:  "ResumeId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetProfile() == nil {
+		err := UserParamsValidationError{
+			field:  "Profile",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserParamsValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserParamsValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserParamsValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserParamsMultiError is an error wrapping multiple validation errors
+// returned by UserParams.ValidateAll() if the designated constraints aren't met.
+type UserParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserParamsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserParamsMultiError) AllErrors() []error { return m }
+
+// UserParamsValidationError is the validation error returned by
+// UserParams.Validate if the designated constraints aren't met.
+type UserParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserParamsValidationError) ErrorName() string { return "UserParamsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserParamsValidationError{}
+
+// Validate checks the field values on UserFilter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserFilterMultiError, or
+// nil if none found.
+func (m *UserFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Surname
+
+	// no validation rules for Patronymic
+
+	// no validation rules for Email
+
+	// no validation rules for CalendarId
+
+	// no validation rules for ResumeId
+
+	// no validation rules for IncludeDeleted
+
+	if len(errors) > 0 {
+		return UserFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserFilterMultiError is an error wrapping multiple validation errors
+// returned by UserFilter.ValidateAll() if the designated constraints aren't met.
+type UserFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserFilterMultiError) AllErrors() []error { return m }
+
+// UserFilterValidationError is the validation error returned by
+// UserFilter.Validate if the designated constraints aren't met.
+type UserFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserFilterValidationError) ErrorName() string { return "UserFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserFilterValidationError{}
+
+// Validate checks the field values on UserSort with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserSort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserSort with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserSortMultiError, or nil
+// if none found.
+func (m *UserSort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserSort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := UserSort_Field_name[int32(m.GetField())]; !ok {
+		err := UserSortValidationError{
+			field:  "Field",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := UserSort_Direction_name[int32(m.GetDirection())]; !ok {
+		err := UserSortValidationError{
+			field:  "Direction",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UserSortMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserSortMultiError is an error wrapping multiple validation errors returned
+// by UserSort.ValidateAll() if the designated constraints aren't met.
+type UserSortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserSortMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserSortMultiError) AllErrors() []error { return m }
+
+// UserSortValidationError is the validation error returned by
+// UserSort.Validate if the designated constraints aren't met.
+type UserSortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserSortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserSortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserSortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserSortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserSortValidationError) ErrorName() string { return "UserSortValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserSortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserSort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserSortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserSortValidationError{}
+
+// Validate checks the field values on UserProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserProfileMultiError, or
+// nil if none found.
+func (m *UserProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 100 {
+		err := UserProfileValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UserProfile_Name_Pattern.MatchString(m.GetName()) {
+		err := UserProfileValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^\\\\p{L}+([ '-]\\\\p{L}+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSurname()); l < 1 || l > 100 {
+		err := UserProfileValidationError{
+			field:  "Surname",
+			reason: "value length must be between 1 and 100 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UserProfile_Surname_Pattern.MatchString(m.GetSurname()) {
+		err := UserProfileValidationError{
+			field:  "Surname",
+			reason: "value does not match regex pattern \"^\\\\p{L}+([ '-]\\\\p{L}+)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPatronymic() != "" {
+
+		if utf8.RuneCountInString(m.GetPatronymic()) > 100 {
+			err := UserProfileValidationError{
+				field:  "Patronymic",
+				reason: "value length must be at most 100 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_UserProfile_Patronymic_Pattern.MatchString(m.GetPatronymic()) {
+			err := UserProfileValidationError{
+				field:  "Patronymic",
+				reason: "value does not match regex pattern \"^\\\\p{L}+([ '-]\\\\p{L}+)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetEmail() != "" {
+
+		if utf8.RuneCountInString(m.GetEmail()) > 254 {
+			err := UserProfileValidationError{
+				field:  "Email",
+				reason: "value length must be at most 254 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if err := m._validateEmail(m.GetEmail()); err != nil {
+			err = UserProfileValidationError{
+				field:  "Email",
+				reason: "value must be a valid email address",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *UserProfile) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *UserProfile) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// UserProfileMultiError is an error wrapping multiple validation errors
+// returned by UserProfile.ValidateAll() if the designated constraints aren't met.
+type UserProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserProfileMultiError) AllErrors() []error { return m }
+
+// UserProfileValidationError is the validation error returned by
+// UserProfile.Validate if the designated constraints aren't met.
+type UserProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserProfileValidationError) ErrorName() string { return "UserProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserProfileValidationError{}
+
+var _UserProfile_Name_Pattern = regexp.MustCompile("^\\p{L}+([ '-]\\p{L}+)*$")
+
+var _UserProfile_Surname_Pattern = regexp.MustCompile("^\\p{L}+([ '-]\\p{L}+)*$")
+
+var _UserProfile_Patronymic_Pattern = regexp.MustCompile("^\\p{L}+([ '-]\\p{L}+)*$")
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := UserValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CalendarId
+
+	// no validation rules for ResumeId
+
+	if all {
+		switch v := interface{}(m.GetProfile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "Profile",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProfile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "Profile",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetDeletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserValidationError{
+					field:  "DeletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserValidationError{
+				field:  "DeletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
 // UserValidationError is the validation error returned by User.Validate if the
 // designated constraints aren't met.
 type UserValidationError struct {
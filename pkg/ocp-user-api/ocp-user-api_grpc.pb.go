@@ -20,10 +20,29 @@ const _ = grpc.SupportPackageIsVersion7
 type OcpUserApiClient interface {
 	ListUsersV1(ctx context.Context, in *ListUsersV1Request, opts ...grpc.CallOption) (*ListUsersV1Response, error)
 	DescribeUserV1(ctx context.Context, in *DescribeUserV1Request, opts ...grpc.CallOption) (*DescribeUserV1Response, error)
+	BatchDescribeUsersV1(ctx context.Context, in *BatchDescribeUsersV1Request, opts ...grpc.CallOption) (*BatchDescribeUsersV1Response, error)
 	CreateUserV1(ctx context.Context, in *CreateUserV1Request, opts ...grpc.CallOption) (*CreateUserV1Response, error)
 	RemoveUserV1(ctx context.Context, in *RemoveUserV1Request, opts ...grpc.CallOption) (*RemoveUserV1Response, error)
+	RestoreUserV1(ctx context.Context, in *RestoreUserV1Request, opts ...grpc.CallOption) (*RestoreUserV1Response, error)
+	// Окончательное удаление пользователей, удаленных ранее заданного момента. Административный метод.
+	PurgeDeletedUsersV1(ctx context.Context, in *PurgeDeletedUsersV1Request, opts ...grpc.CallOption) (*PurgeDeletedUsersV1Response, error)
 	MultiCreateUserV1(ctx context.Context, in *MultiCreateUserV1Request, opts ...grpc.CallOption) (*MultiCreateUserV1Response, error)
 	UpdateUserV1(ctx context.Context, in *UpdateUserV1Request, opts ...grpc.CallOption) (*UpdateUserV1Response, error)
+	// Обновление нескольких пользователей в одной транзакции с результатом для каждого пользователя.
+	MultiUpdateUserV1(ctx context.Context, in *MultiUpdateUserV1Request, opts ...grpc.CallOption) (*MultiUpdateUserV1Response, error)
+	// Удаление нескольких пользователей в одной транзакции с результатом для каждого пользователя.
+	MultiRemoveUserV1(ctx context.Context, in *MultiRemoveUserV1Request, opts ...grpc.CallOption) (*MultiRemoveUserV1Response, error)
+	// Потоковый импорт пользователей. Пользователи накапливаются в буфере и сохраняются пачками,
+	// по завершении потока возвращаются итоги импорта.
+	ImportUsersV1(ctx context.Context, opts ...grpc.CallOption) (OcpUserApi_ImportUsersV1Client, error)
+	// Потоковый импорт пользователей с подтверждениями: после каждого сохранения буфера отправляется
+	// текущий прогресс, последним отправляются итоги импорта с признаком done.
+	ImportUsersWithProgressV1(ctx context.Context, opts ...grpc.CallOption) (OcpUserApi_ImportUsersWithProgressV1Client, error)
+	// Выгрузка всех пользователей, удовлетворяющих фильтру, из согласованного снимка БД.
+	// Пользователи передаются пачками; по HTTP выгрузка доступна по адресу /v1/users:export в форматах NDJSON и CSV.
+	ExportUsersV1(ctx context.Context, in *ExportUsersV1Request, opts ...grpc.CallOption) (OcpUserApi_ExportUsersV1Client, error)
+	// История изменений пользователя в порядке изменений. История доступна и для удаленных пользователей.
+	ListUserHistoryV1(ctx context.Context, in *ListUserHistoryV1Request, opts ...grpc.CallOption) (*ListUserHistoryV1Response, error)
 }
 
 type ocpUserApiClient struct {
@@ -52,6 +71,15 @@ func (c *ocpUserApiClient) DescribeUserV1(ctx context.Context, in *DescribeUserV
 	return out, nil
 }
 
+func (c *ocpUserApiClient) BatchDescribeUsersV1(ctx context.Context, in *BatchDescribeUsersV1Request, opts ...grpc.CallOption) (*BatchDescribeUsersV1Response, error) {
+	out := new(BatchDescribeUsersV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/BatchDescribeUsersV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpUserApiClient) CreateUserV1(ctx context.Context, in *CreateUserV1Request, opts ...grpc.CallOption) (*CreateUserV1Response, error) {
 	out := new(CreateUserV1Response)
 	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/CreateUserV1", in, out, opts...)
@@ -70,6 +98,24 @@ func (c *ocpUserApiClient) RemoveUserV1(ctx context.Context, in *RemoveUserV1Req
 	return out, nil
 }
 
+func (c *ocpUserApiClient) RestoreUserV1(ctx context.Context, in *RestoreUserV1Request, opts ...grpc.CallOption) (*RestoreUserV1Response, error) {
+	out := new(RestoreUserV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/RestoreUserV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpUserApiClient) PurgeDeletedUsersV1(ctx context.Context, in *PurgeDeletedUsersV1Request, opts ...grpc.CallOption) (*PurgeDeletedUsersV1Response, error) {
+	out := new(PurgeDeletedUsersV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/PurgeDeletedUsersV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ocpUserApiClient) MultiCreateUserV1(ctx context.Context, in *MultiCreateUserV1Request, opts ...grpc.CallOption) (*MultiCreateUserV1Response, error) {
 	out := new(MultiCreateUserV1Response)
 	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/MultiCreateUserV1", in, out, opts...)
@@ -88,16 +134,159 @@ func (c *ocpUserApiClient) UpdateUserV1(ctx context.Context, in *UpdateUserV1Req
 	return out, nil
 }
 
+func (c *ocpUserApiClient) MultiUpdateUserV1(ctx context.Context, in *MultiUpdateUserV1Request, opts ...grpc.CallOption) (*MultiUpdateUserV1Response, error) {
+	out := new(MultiUpdateUserV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/MultiUpdateUserV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpUserApiClient) MultiRemoveUserV1(ctx context.Context, in *MultiRemoveUserV1Request, opts ...grpc.CallOption) (*MultiRemoveUserV1Response, error) {
+	out := new(MultiRemoveUserV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/MultiRemoveUserV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ocpUserApiClient) ImportUsersV1(ctx context.Context, opts ...grpc.CallOption) (OcpUserApi_ImportUsersV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpUserApi_ServiceDesc.Streams[0], "/ocp.user.api.OcpUserApi/ImportUsersV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpUserApiImportUsersV1Client{stream}
+	return x, nil
+}
+
+type OcpUserApi_ImportUsersV1Client interface {
+	Send(*ImportUsersV1Request) error
+	CloseAndRecv() (*ImportUsersV1Response, error)
+	grpc.ClientStream
+}
+
+type ocpUserApiImportUsersV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *ocpUserApiImportUsersV1Client) Send(m *ImportUsersV1Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ocpUserApiImportUsersV1Client) CloseAndRecv() (*ImportUsersV1Response, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportUsersV1Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpUserApiClient) ImportUsersWithProgressV1(ctx context.Context, opts ...grpc.CallOption) (OcpUserApi_ImportUsersWithProgressV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpUserApi_ServiceDesc.Streams[1], "/ocp.user.api.OcpUserApi/ImportUsersWithProgressV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpUserApiImportUsersWithProgressV1Client{stream}
+	return x, nil
+}
+
+type OcpUserApi_ImportUsersWithProgressV1Client interface {
+	Send(*ImportUsersV1Request) error
+	Recv() (*ImportUsersV1Response, error)
+	grpc.ClientStream
+}
+
+type ocpUserApiImportUsersWithProgressV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *ocpUserApiImportUsersWithProgressV1Client) Send(m *ImportUsersV1Request) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ocpUserApiImportUsersWithProgressV1Client) Recv() (*ImportUsersV1Response, error) {
+	m := new(ImportUsersV1Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpUserApiClient) ExportUsersV1(ctx context.Context, in *ExportUsersV1Request, opts ...grpc.CallOption) (OcpUserApi_ExportUsersV1Client, error) {
+	stream, err := c.cc.NewStream(ctx, &OcpUserApi_ServiceDesc.Streams[2], "/ocp.user.api.OcpUserApi/ExportUsersV1", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ocpUserApiExportUsersV1Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OcpUserApi_ExportUsersV1Client interface {
+	Recv() (*ExportUsersV1Response, error)
+	grpc.ClientStream
+}
+
+type ocpUserApiExportUsersV1Client struct {
+	grpc.ClientStream
+}
+
+func (x *ocpUserApiExportUsersV1Client) Recv() (*ExportUsersV1Response, error) {
+	m := new(ExportUsersV1Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ocpUserApiClient) ListUserHistoryV1(ctx context.Context, in *ListUserHistoryV1Request, opts ...grpc.CallOption) (*ListUserHistoryV1Response, error) {
+	out := new(ListUserHistoryV1Response)
+	err := c.cc.Invoke(ctx, "/ocp.user.api.OcpUserApi/ListUserHistoryV1", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OcpUserApiServer is the server API for OcpUserApi service.
 // All implementations must embed UnimplementedOcpUserApiServer
 // for forward compatibility
 type OcpUserApiServer interface {
 	ListUsersV1(context.Context, *ListUsersV1Request) (*ListUsersV1Response, error)
 	DescribeUserV1(context.Context, *DescribeUserV1Request) (*DescribeUserV1Response, error)
+	BatchDescribeUsersV1(context.Context, *BatchDescribeUsersV1Request) (*BatchDescribeUsersV1Response, error)
 	CreateUserV1(context.Context, *CreateUserV1Request) (*CreateUserV1Response, error)
 	RemoveUserV1(context.Context, *RemoveUserV1Request) (*RemoveUserV1Response, error)
+	RestoreUserV1(context.Context, *RestoreUserV1Request) (*RestoreUserV1Response, error)
+	// Окончательное удаление пользователей, удаленных ранее заданного момента. Административный метод.
+	PurgeDeletedUsersV1(context.Context, *PurgeDeletedUsersV1Request) (*PurgeDeletedUsersV1Response, error)
 	MultiCreateUserV1(context.Context, *MultiCreateUserV1Request) (*MultiCreateUserV1Response, error)
 	UpdateUserV1(context.Context, *UpdateUserV1Request) (*UpdateUserV1Response, error)
+	// Обновление нескольких пользователей в одной транзакции с результатом для каждого пользователя.
+	MultiUpdateUserV1(context.Context, *MultiUpdateUserV1Request) (*MultiUpdateUserV1Response, error)
+	// Удаление нескольких пользователей в одной транзакции с результатом для каждого пользователя.
+	MultiRemoveUserV1(context.Context, *MultiRemoveUserV1Request) (*MultiRemoveUserV1Response, error)
+	// Потоковый импорт пользователей. Пользователи накапливаются в буфере и сохраняются пачками,
+	// по завершении потока возвращаются итоги импорта.
+	ImportUsersV1(OcpUserApi_ImportUsersV1Server) error
+	// Потоковый импорт пользователей с подтверждениями: после каждого сохранения буфера отправляется
+	// текущий прогресс, последним отправляются итоги импорта с признаком done.
+	ImportUsersWithProgressV1(OcpUserApi_ImportUsersWithProgressV1Server) error
+	// Выгрузка всех пользователей, удовлетворяющих фильтру, из согласованного снимка БД.
+	// Пользователи передаются пачками; по HTTP выгрузка доступна по адресу /v1/users:export в форматах NDJSON и CSV.
+	ExportUsersV1(*ExportUsersV1Request, OcpUserApi_ExportUsersV1Server) error
+	// История изменений пользователя в порядке изменений. История доступна и для удаленных пользователей.
+	ListUserHistoryV1(context.Context, *ListUserHistoryV1Request) (*ListUserHistoryV1Response, error)
 	mustEmbedUnimplementedOcpUserApiServer()
 }
 
@@ -111,18 +300,45 @@ func (UnimplementedOcpUserApiServer) ListUsersV1(context.Context, *ListUsersV1Re
 func (UnimplementedOcpUserApiServer) DescribeUserV1(context.Context, *DescribeUserV1Request) (*DescribeUserV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DescribeUserV1 not implemented")
 }
+func (UnimplementedOcpUserApiServer) BatchDescribeUsersV1(context.Context, *BatchDescribeUsersV1Request) (*BatchDescribeUsersV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDescribeUsersV1 not implemented")
+}
 func (UnimplementedOcpUserApiServer) CreateUserV1(context.Context, *CreateUserV1Request) (*CreateUserV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUserV1 not implemented")
 }
 func (UnimplementedOcpUserApiServer) RemoveUserV1(context.Context, *RemoveUserV1Request) (*RemoveUserV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUserV1 not implemented")
 }
+func (UnimplementedOcpUserApiServer) RestoreUserV1(context.Context, *RestoreUserV1Request) (*RestoreUserV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUserV1 not implemented")
+}
+func (UnimplementedOcpUserApiServer) PurgeDeletedUsersV1(context.Context, *PurgeDeletedUsersV1Request) (*PurgeDeletedUsersV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeletedUsersV1 not implemented")
+}
 func (UnimplementedOcpUserApiServer) MultiCreateUserV1(context.Context, *MultiCreateUserV1Request) (*MultiCreateUserV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiCreateUserV1 not implemented")
 }
 func (UnimplementedOcpUserApiServer) UpdateUserV1(context.Context, *UpdateUserV1Request) (*UpdateUserV1Response, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserV1 not implemented")
 }
+func (UnimplementedOcpUserApiServer) MultiUpdateUserV1(context.Context, *MultiUpdateUserV1Request) (*MultiUpdateUserV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiUpdateUserV1 not implemented")
+}
+func (UnimplementedOcpUserApiServer) MultiRemoveUserV1(context.Context, *MultiRemoveUserV1Request) (*MultiRemoveUserV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiRemoveUserV1 not implemented")
+}
+func (UnimplementedOcpUserApiServer) ImportUsersV1(OcpUserApi_ImportUsersV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ImportUsersV1 not implemented")
+}
+func (UnimplementedOcpUserApiServer) ImportUsersWithProgressV1(OcpUserApi_ImportUsersWithProgressV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ImportUsersWithProgressV1 not implemented")
+}
+func (UnimplementedOcpUserApiServer) ExportUsersV1(*ExportUsersV1Request, OcpUserApi_ExportUsersV1Server) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUsersV1 not implemented")
+}
+func (UnimplementedOcpUserApiServer) ListUserHistoryV1(context.Context, *ListUserHistoryV1Request) (*ListUserHistoryV1Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserHistoryV1 not implemented")
+}
 func (UnimplementedOcpUserApiServer) mustEmbedUnimplementedOcpUserApiServer() {}
 
 // UnsafeOcpUserApiServer may be embedded to opt out of forward compatibility for this service.
@@ -172,6 +388,24 @@ func _OcpUserApi_DescribeUserV1_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpUserApi_BatchDescribeUsersV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDescribeUsersV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpUserApiServer).BatchDescribeUsersV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.user.api.OcpUserApi/BatchDescribeUsersV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpUserApiServer).BatchDescribeUsersV1(ctx, req.(*BatchDescribeUsersV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpUserApi_CreateUserV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateUserV1Request)
 	if err := dec(in); err != nil {
@@ -208,6 +442,42 @@ func _OcpUserApi_RemoveUserV1_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpUserApi_RestoreUserV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpUserApiServer).RestoreUserV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.user.api.OcpUserApi/RestoreUserV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpUserApiServer).RestoreUserV1(ctx, req.(*RestoreUserV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpUserApi_PurgeDeletedUsersV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDeletedUsersV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpUserApiServer).PurgeDeletedUsersV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.user.api.OcpUserApi/PurgeDeletedUsersV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpUserApiServer).PurgeDeletedUsersV1(ctx, req.(*PurgeDeletedUsersV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OcpUserApi_MultiCreateUserV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MultiCreateUserV1Request)
 	if err := dec(in); err != nil {
@@ -244,6 +514,133 @@ func _OcpUserApi_UpdateUserV1_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OcpUserApi_MultiUpdateUserV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiUpdateUserV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpUserApiServer).MultiUpdateUserV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.user.api.OcpUserApi/MultiUpdateUserV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpUserApiServer).MultiUpdateUserV1(ctx, req.(*MultiUpdateUserV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpUserApi_MultiRemoveUserV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiRemoveUserV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpUserApiServer).MultiRemoveUserV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.user.api.OcpUserApi/MultiRemoveUserV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpUserApiServer).MultiRemoveUserV1(ctx, req.(*MultiRemoveUserV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OcpUserApi_ImportUsersV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OcpUserApiServer).ImportUsersV1(&ocpUserApiImportUsersV1Server{stream})
+}
+
+type OcpUserApi_ImportUsersV1Server interface {
+	SendAndClose(*ImportUsersV1Response) error
+	Recv() (*ImportUsersV1Request, error)
+	grpc.ServerStream
+}
+
+type ocpUserApiImportUsersV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *ocpUserApiImportUsersV1Server) SendAndClose(m *ImportUsersV1Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ocpUserApiImportUsersV1Server) Recv() (*ImportUsersV1Request, error) {
+	m := new(ImportUsersV1Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _OcpUserApi_ImportUsersWithProgressV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(OcpUserApiServer).ImportUsersWithProgressV1(&ocpUserApiImportUsersWithProgressV1Server{stream})
+}
+
+type OcpUserApi_ImportUsersWithProgressV1Server interface {
+	Send(*ImportUsersV1Response) error
+	Recv() (*ImportUsersV1Request, error)
+	grpc.ServerStream
+}
+
+type ocpUserApiImportUsersWithProgressV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *ocpUserApiImportUsersWithProgressV1Server) Send(m *ImportUsersV1Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ocpUserApiImportUsersWithProgressV1Server) Recv() (*ImportUsersV1Request, error) {
+	m := new(ImportUsersV1Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _OcpUserApi_ExportUsersV1_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUsersV1Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OcpUserApiServer).ExportUsersV1(m, &ocpUserApiExportUsersV1Server{stream})
+}
+
+type OcpUserApi_ExportUsersV1Server interface {
+	Send(*ExportUsersV1Response) error
+	grpc.ServerStream
+}
+
+type ocpUserApiExportUsersV1Server struct {
+	grpc.ServerStream
+}
+
+func (x *ocpUserApiExportUsersV1Server) Send(m *ExportUsersV1Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _OcpUserApi_ListUserHistoryV1_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserHistoryV1Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OcpUserApiServer).ListUserHistoryV1(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ocp.user.api.OcpUserApi/ListUserHistoryV1",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OcpUserApiServer).ListUserHistoryV1(ctx, req.(*ListUserHistoryV1Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OcpUserApi_ServiceDesc is the grpc.ServiceDesc for OcpUserApi service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +656,10 @@ var OcpUserApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DescribeUserV1",
 			Handler:    _OcpUserApi_DescribeUserV1_Handler,
 		},
+		{
+			MethodName: "BatchDescribeUsersV1",
+			Handler:    _OcpUserApi_BatchDescribeUsersV1_Handler,
+		},
 		{
 			MethodName: "CreateUserV1",
 			Handler:    _OcpUserApi_CreateUserV1_Handler,
@@ -267,6 +668,14 @@ var OcpUserApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUserV1",
 			Handler:    _OcpUserApi_RemoveUserV1_Handler,
 		},
+		{
+			MethodName: "RestoreUserV1",
+			Handler:    _OcpUserApi_RestoreUserV1_Handler,
+		},
+		{
+			MethodName: "PurgeDeletedUsersV1",
+			Handler:    _OcpUserApi_PurgeDeletedUsersV1_Handler,
+		},
 		{
 			MethodName: "MultiCreateUserV1",
 			Handler:    _OcpUserApi_MultiCreateUserV1_Handler,
@@ -275,7 +684,36 @@ var OcpUserApi_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserV1",
 			Handler:    _OcpUserApi_UpdateUserV1_Handler,
 		},
+		{
+			MethodName: "MultiUpdateUserV1",
+			Handler:    _OcpUserApi_MultiUpdateUserV1_Handler,
+		},
+		{
+			MethodName: "MultiRemoveUserV1",
+			Handler:    _OcpUserApi_MultiRemoveUserV1_Handler,
+		},
+		{
+			MethodName: "ListUserHistoryV1",
+			Handler:    _OcpUserApi_ListUserHistoryV1_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportUsersV1",
+			Handler:       _OcpUserApi_ImportUsersV1_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ImportUsersWithProgressV1",
+			Handler:       _OcpUserApi_ImportUsersWithProgressV1_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportUsersV1",
+			Handler:       _OcpUserApi_ExportUsersV1_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/ocp-user-api/ocp-user-api.proto",
 }
@@ -0,0 +1,9 @@
+package swagger
+
+import (
+	_ "embed"
+)
+
+// Спецификация REST API, сгенерированная protoc-gen-swagger.
+//go:embed api.swagger.json
+var Spec []byte